@@ -29,17 +29,33 @@ type API struct {
 // secret as the X-Hasura-Admin-Secret header on every request when non-empty. The
 // returned client reuses a single *http.Client with connection pooling tuned for
 // concurrent use (up to 100 idle connections, 100 per host) and a one-minute
-// per-request timeout.
-func New(baseURL, secret string) *API {
+// per-request timeout. Options can override the client settings.
+func New(baseURL, secret string, opts ...Option) *API {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.MaxIdleConns = 100
 	t.MaxConnsPerHost = 100
 	t.MaxIdleConnsPerHost = 100
 
-	return &API{baseURL, secret, &http.Client{
+	api := &API{baseURL, secret, &http.Client{
 		Timeout:   time.Minute,
 		Transport: t,
 	}}
+	for i := range opts {
+		opts[i](api)
+	}
+	return api
+}
+
+// Option -
+type Option func(*API)
+
+// WithTransport - sets round tripper of Hasura API client
+func WithTransport(transport http.RoundTripper) Option {
+	return func(api *API) {
+		if transport != nil {
+			api.client.Transport = transport
+		}
+	}
 }
 
 func (api *API) buildURL(endpoint string, args map[string]string) (string, error) {
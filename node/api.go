@@ -1,6 +1,11 @@
 package node
 
-import jsoniter "github.com/json-iterator/go"
+import (
+	"time"
+
+	"github.com/dipdup-io/go-lib/config"
+	jsoniter "github.com/json-iterator/go"
+)
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
@@ -14,6 +19,7 @@ type API interface {
 	ProtocolsAPI
 	NetworkAPI
 	InjectAPI
+	HelpersAPI
 }
 
 // RPC -
@@ -26,32 +32,39 @@ type RPC struct {
 	*Protocols
 	*Network
 	*Inject
+	*Helpers
 }
 
 // NewRPC -
-func NewRPC(baseURL, chainID string) *RPC {
+func NewRPC(baseURL, chainID string, opts ...ClientOption) *RPC {
 	return &RPC{
-		BlockRPC:  NewBlockRPC(baseURL, chainID),
-		Chain:     NewChain(baseURL, chainID),
-		Context:   NewContext(baseURL, chainID),
-		Config:    NewConfig(baseURL),
-		General:   NewGeneral(baseURL),
-		Protocols: NewProtocols(baseURL),
-		Network:   NewNetwork(baseURL),
-		Inject:    NewInject(baseURL),
+		BlockRPC:  NewBlockRPC(baseURL, chainID, opts...),
+		Chain:     NewChain(baseURL, chainID, opts...),
+		Context:   NewContext(baseURL, chainID, opts...),
+		Config:    NewConfig(baseURL, opts...),
+		General:   NewGeneral(baseURL, opts...),
+		Protocols: NewProtocols(baseURL, opts...),
+		Network:   NewNetwork(baseURL, opts...),
+		Inject:    NewInject(baseURL, opts...),
+		Helpers:   NewHelpers(baseURL, chainID, opts...),
 	}
 }
 
 // NewMainRPC -
-func NewMainRPC(baseURL string) *RPC {
-	return &RPC{
-		BlockRPC:  NewMainBlockRPC(baseURL),
-		Chain:     NewMainChain(baseURL),
-		Context:   NewMainContext(baseURL),
-		Config:    NewConfig(baseURL),
-		General:   NewGeneral(baseURL),
-		Protocols: NewProtocols(baseURL),
-		Network:   NewNetwork(baseURL),
-		Inject:    NewInject(baseURL),
+func NewMainRPC(baseURL string, opts ...ClientOption) *RPC {
+	return NewRPC(baseURL, "main", opts...)
+}
+
+// NewRPCFromDataSource - creates RPC for `main` chain using URL, timeout and requests per second limit from data source config.
+// Failed requests are retried with default parameters. Passed options are applied after the config ones.
+func NewRPCFromDataSource(cfg config.DataSource, opts ...ClientOption) *RPC {
+	options := []ClientOption{
+		WithDefaultRetry(),
+		WithRateLimit(cfg.RequestsPerSecond),
+	}
+	if cfg.Timeout > 0 {
+		options = append(options, WithTimeout(time.Duration(cfg.Timeout)*time.Second))
 	}
+	options = append(options, opts...)
+	return NewMainRPC(cfg.URL, options...)
 }
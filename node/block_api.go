@@ -46,17 +46,17 @@ type BlockRPC struct {
 }
 
 // NewChain -
-func NewBlockRPC(baseURL, chainID string) *BlockRPC {
+func NewBlockRPC(baseURL, chainID string, opts ...ClientOption) *BlockRPC {
 	return &BlockRPC{
 		baseURL: strings.TrimSuffix(baseURL, "/"),
 		chainID: chainID,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
 // NewMainBlockRPC -
-func NewMainBlockRPC(baseURL string) *BlockRPC {
-	return NewBlockRPC(baseURL, "main")
+func NewMainBlockRPC(baseURL string, opts ...ClientOption) *BlockRPC {
+	return NewBlockRPC(baseURL, "main", opts...)
 }
 
 // Blocks -
@@ -5,22 +5,27 @@ import (
 	"time"
 )
 
-// Header -
+// Header - block header. `Priority` is filled before Ithaca, payload fields and votes since Ithaca.
 type Header struct {
-	Protocol         string    `json:"protocol"`
-	ChainID          string    `json:"chain_id"`
-	Hash             string    `json:"hash"`
-	Level            uint64    `json:"level"`
-	Proto            int       `json:"proto"`
-	Predecessor      string    `json:"predecessor"`
-	Timestamp        time.Time `json:"timestamp"`
-	ValidationPass   int       `json:"validation_pass"`
-	OperationsHash   string    `json:"operations_hash"`
-	Fitness          []string  `json:"fitness"`
-	Context          string    `json:"context"`
-	Priority         int       `json:"priority"`
-	ProofOfWorkNonce string    `json:"proof_of_work_nonce"`
-	Signature        string    `json:"signature"`
+	Protocol                  string    `json:"protocol"`
+	ChainID                   string    `json:"chain_id"`
+	Hash                      string    `json:"hash"`
+	Level                     uint64    `json:"level"`
+	Proto                     int       `json:"proto"`
+	Predecessor               string    `json:"predecessor"`
+	Timestamp                 time.Time `json:"timestamp"`
+	ValidationPass            int       `json:"validation_pass"`
+	OperationsHash            string    `json:"operations_hash"`
+	Fitness                   []string  `json:"fitness"`
+	Context                   string    `json:"context"`
+	Priority                  int       `json:"priority"`
+	PayloadHash               string    `json:"payload_hash,omitempty"`
+	PayloadRound              int       `json:"payload_round,omitempty"`
+	ProofOfWorkNonce          string    `json:"proof_of_work_nonce"`
+	SeedNonceHash             string    `json:"seed_nonce_hash,omitempty"`
+	LiquidityBakingToggleVote string    `json:"liquidity_baking_toggle_vote,omitempty"`
+	AdaptiveIssuanceVote      string    `json:"adaptive_issuance_vote,omitempty"`
+	Signature                 string    `json:"signature"`
 }
 
 // BlockMetadata -
@@ -54,6 +59,7 @@ type BlockMetadata struct {
 	BalanceUpdates            []BalanceUpdate            `json:"balance_updates"`
 	LiquidityBakingEscapeEma  uint64                     `json:"liquidity_baking_escape_ema"`
 	ImplicitOperationsResults []ImplicitOperationsResult `json:"implicit_operations_results"`
+	DalAttestation            string                     `json:"dal_attestation,omitempty"`
 }
 
 // Block -
@@ -153,10 +159,29 @@ type VotingPeriod struct {
 	Remaining int `json:"remaining"`
 }
 
-// Rolls -
+// Rolls - entry of voting listings. Before Jakarta voting power was counted in rolls, later it's counted in mutez and encoded as string.
+// Recent protocols return delegate in `delegate` field instead of `pkh`.
 type Rolls struct {
-	Pkh   string `json:"pkh"`
-	Rolls int    `json:"rolls"`
+	Pkh         string `json:"pkh"`
+	Rolls       int    `json:"rolls,omitempty"`
+	VotingPower int64  `json:"voting_power,string,omitempty"`
+}
+
+// UnmarshalJSON -
+func (r *Rolls) UnmarshalJSON(data []byte) error {
+	type buf Rolls
+	var value struct {
+		buf
+		Delegate string `json:"delegate"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*r = Rolls(value.buf)
+	if r.Pkh == "" {
+		r.Pkh = value.Delegate
+	}
+	return nil
 }
 
 // BlocksArgs -
@@ -0,0 +1,54 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolls_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []Rolls
+		wantErr bool
+	}{
+		{
+			name: "before Jakarta",
+			data: `[{"pkh":"tz3RDC3Jdn4j15J7bBHZd29EUee9gVB1CxD9","rolls":13212},{"pkh":"tz1Yju7jmmsaUiG9qQLoYv35v5pHgnWoLWbt","rolls":18}]`,
+			want: []Rolls{
+				{Pkh: "tz3RDC3Jdn4j15J7bBHZd29EUee9gVB1CxD9", Rolls: 13212},
+				{Pkh: "tz1Yju7jmmsaUiG9qQLoYv35v5pHgnWoLWbt", Rolls: 18},
+			},
+		}, {
+			name: "after Jakarta",
+			data: `[{"pkh":"tz3RDC3Jdn4j15J7bBHZd29EUee9gVB1CxD9","voting_power":"105698215011286"},{"pkh":"tz1Yju7jmmsaUiG9qQLoYv35v5pHgnWoLWbt","voting_power":"144129383465"}]`,
+			want: []Rolls{
+				{Pkh: "tz3RDC3Jdn4j15J7bBHZd29EUee9gVB1CxD9", VotingPower: 105698215011286},
+				{Pkh: "tz1Yju7jmmsaUiG9qQLoYv35v5pHgnWoLWbt", VotingPower: 144129383465},
+			},
+		}, {
+			name: "delegate field",
+			data: `[{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","voting_power":"6033427425018"}]`,
+			want: []Rolls{
+				{Pkh: "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", VotingPower: 6033427425018},
+			},
+		}, {
+			name:    "invalid voting power",
+			data:    `[{"pkh":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","voting_power":"abc"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Rolls
+			err := json.UnmarshalFromString(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
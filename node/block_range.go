@@ -0,0 +1,293 @@
+package node
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// default range fetcher parameters
+const (
+	DefaultRangeWorkers  = 4
+	DefaultRangeInFlight = 32
+)
+
+// RangeBlock - block returned by `BlockRangeFetcher`
+type RangeBlock struct {
+	Level uint64
+	Block
+
+	// BigMaps - raw values of big maps requested by `WithBigMaps` at the block level
+	BigMaps map[uint64][]byte
+}
+
+// RangeOption -
+type RangeOption func(*BlockRangeFetcher)
+
+// WithWorkers - sets count of concurrent requests. Default: 4.
+func WithWorkers(workers int) RangeOption {
+	return func(f *BlockRangeFetcher) {
+		if workers > 0 {
+			f.workers = workers
+		}
+	}
+}
+
+// WithInFlight - sets maximum count of fetched blocks which wait for emitting because a previous level is not received yet.
+// It limits memory usage when some level is slow. It can't be less than workers count. Default: 32.
+func WithInFlight(limit int) RangeOption {
+	return func(f *BlockRangeFetcher) {
+		if limit > 0 {
+			f.inFlight = limit
+		}
+	}
+}
+
+// WithRangeRetry - sets retry parameters of a single level. Default: 3 attempts with backoff from 200ms to 10s.
+func WithRangeRetry(attempts int, minBackoff, maxBackoff time.Duration) RangeOption {
+	return func(f *BlockRangeFetcher) {
+		if attempts < 1 {
+			attempts = 1
+		}
+		if minBackoff <= 0 {
+			minBackoff = DefaultRetryMinBackoff
+		}
+		if maxBackoff < minBackoff {
+			maxBackoff = minBackoff
+		}
+		f.retry = retryPolicy{
+			attempts:   attempts,
+			minBackoff: minBackoff,
+			maxBackoff: maxBackoff,
+		}
+	}
+}
+
+// WithOperations - requests full blocks with operations. By default only header and metadata are requested.
+func WithOperations() RangeOption {
+	return func(f *BlockRangeFetcher) {
+		f.withOperations = true
+	}
+}
+
+// WithBigMaps - requests values of big maps `ids` at every level
+func WithBigMaps(api ContextAPI, ids ...uint64) RangeOption {
+	return func(f *BlockRangeFetcher) {
+		f.context = api
+		f.bigMaps = ids
+	}
+}
+
+// BlockRangeFetcher - fetches blocks of levels range concurrently and returns them strictly in level order
+type BlockRangeFetcher struct {
+	api     BlockAPI
+	context ContextAPI
+
+	workers        int
+	inFlight       int
+	retry          retryPolicy
+	withOperations bool
+	bigMaps        []uint64
+}
+
+// NewBlockRangeFetcher -
+func NewBlockRangeFetcher(api BlockAPI, opts ...RangeOption) *BlockRangeFetcher {
+	f := &BlockRangeFetcher{
+		api:      api,
+		workers:  DefaultRangeWorkers,
+		inFlight: DefaultRangeInFlight,
+		retry: retryPolicy{
+			attempts:   DefaultRetryAttempts,
+			minBackoff: DefaultRetryMinBackoff,
+			maxBackoff: DefaultRetryMaxBackoff,
+		},
+	}
+	for i := range opts {
+		opts[i](f)
+	}
+	if f.inFlight < f.workers {
+		f.inFlight = f.workers
+	}
+	return f
+}
+
+// BlockRange - iterates over blocks from `from` to `to` inclusive in level order
+func (api *BlockRPC) BlockRange(ctx context.Context, from, to uint64, opts ...RangeOption) iter.Seq2[RangeBlock, error] {
+	return NewBlockRangeFetcher(api, opts...).Blocks(ctx, from, to)
+}
+
+type rangeResult struct {
+	block RangeBlock
+	err   error
+}
+
+// Blocks - iterates over blocks from `from` to `to` inclusive in level order. Iteration stops after the first error
+// which is returned with the failed level.
+func (f *BlockRangeFetcher) Blocks(ctx context.Context, from, to uint64) iter.Seq2[RangeBlock, error] {
+	return func(yield func(RangeBlock, error) bool) {
+		if from > to {
+			return
+		}
+
+		fetchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			levels  = make(chan uint64)
+			results = make(chan rangeResult, f.inFlight)
+			slots   = make(chan struct{}, f.inFlight)
+			wg      sync.WaitGroup
+		)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(levels)
+
+			for level := from; ; level++ {
+				select {
+				case <-fetchCtx.Done():
+					return
+				case slots <- struct{}{}:
+				}
+				select {
+				case <-fetchCtx.Done():
+					return
+				case levels <- level:
+				}
+				if level == to {
+					return
+				}
+			}
+		}()
+
+		for range f.workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for level := range levels {
+					block, err := f.fetch(fetchCtx, level)
+					select {
+					case <-fetchCtx.Done():
+						return
+					case results <- rangeResult{block, err}:
+					}
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		pending := make(map[uint64]rangeResult)
+		next := from
+		for result := range results {
+			pending[result.block.Level] = result
+
+			for {
+				current, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				<-slots
+
+				if current.err != nil {
+					yield(current.block, current.err)
+					return
+				}
+				if !yield(current.block, nil) || next == to {
+					return
+				}
+				next++
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			yield(RangeBlock{Level: next}, err)
+		}
+	}
+}
+
+// Stream - sends blocks from `from` to `to` inclusive to `output` in level order. It returns the first error.
+func (f *BlockRangeFetcher) Stream(ctx context.Context, from, to uint64, output chan<- RangeBlock) error {
+	for block, err := range f.Blocks(ctx, from, to) {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case output <- block:
+		}
+	}
+	return nil
+}
+
+func (f *BlockRangeFetcher) fetch(ctx context.Context, level uint64) (RangeBlock, error) {
+	for attempt := 1; ; attempt++ {
+		block, err := f.fetchLevel(ctx, level)
+		if err == nil || attempt >= f.retry.attempts || ctx.Err() != nil {
+			return block, err
+		}
+
+		timer := time.NewTimer(f.retry.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return block, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (f *BlockRangeFetcher) fetchLevel(ctx context.Context, level uint64) (RangeBlock, error) {
+	result := RangeBlock{
+		Level: level,
+	}
+	blockID := strconv.FormatUint(level, 10)
+
+	if f.withOperations {
+		block, err := f.api.Block(ctx, blockID)
+		if err != nil {
+			return result, errors.Wrapf(err, "block %d", level)
+		}
+		result.Block = block
+	} else {
+		header, err := f.api.Header(ctx, blockID)
+		if err != nil {
+			return result, errors.Wrapf(err, "header %d", level)
+		}
+		metadata, err := f.api.Metadata(ctx, blockID)
+		if err != nil {
+			return result, errors.Wrapf(err, "metadata %d", level)
+		}
+		result.Block = Block{
+			Protocol: header.Protocol,
+			ChainID:  header.ChainID,
+			Hash:     header.Hash,
+			Header:   header,
+			Metadata: metadata,
+		}
+	}
+
+	if f.context != nil && len(f.bigMaps) > 0 {
+		result.BigMaps = make(map[uint64][]byte, len(f.bigMaps))
+		for _, id := range f.bigMaps {
+			data, err := f.context.BigMap(ctx, blockID, id)
+			if err != nil {
+				return result, errors.Wrapf(err, "big map %d at %d", id, level)
+			}
+			result.BigMaps[id] = data
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,208 @@
+package node
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBlockAPI - block API which answers header requests with configurable delays and failures
+type fakeBlockAPI struct {
+	BlockAPI
+
+	delay    func(level uint64) time.Duration
+	failures map[uint64]int
+	block    uint64
+
+	mx        sync.Mutex
+	attempts  map[uint64]int
+	completed []uint64
+}
+
+func newFakeBlockAPI() *fakeBlockAPI {
+	return &fakeBlockAPI{
+		failures: make(map[uint64]int),
+		attempts: make(map[uint64]int),
+	}
+}
+
+func (api *fakeBlockAPI) Header(ctx context.Context, blockID string) (Header, error) {
+	level, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return Header{}, err
+	}
+
+	if level == api.block {
+		<-ctx.Done()
+		return Header{}, ctx.Err()
+	}
+	if api.delay != nil {
+		select {
+		case <-ctx.Done():
+			return Header{}, ctx.Err()
+		case <-time.After(api.delay(level)):
+		}
+	}
+
+	api.mx.Lock()
+	defer api.mx.Unlock()
+
+	api.attempts[level]++
+	if api.attempts[level] <= api.failures[level] {
+		return Header{}, errors.Errorf("level %d is unavailable", level)
+	}
+	api.completed = append(api.completed, level)
+	return Header{Level: level, Hash: blockID}, nil
+}
+
+func (api *fakeBlockAPI) Metadata(ctx context.Context, blockID string) (BlockMetadata, error) {
+	return BlockMetadata{}, nil
+}
+
+// requireNoLeak - checks that goroutines of fetcher are finished
+func requireNoLeak(t *testing.T, before int) {
+	t.Helper()
+	// require.Eventually isn't used because it runs condition in a separate goroutine
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), before, "goroutines of fetcher are leaked")
+}
+
+func collectLevels(t *testing.T, blocks iter.Seq2[RangeBlock, error]) ([]uint64, error) {
+	t.Helper()
+	levels := make([]uint64, 0)
+	for block, err := range blocks {
+		if err != nil {
+			return levels, err
+		}
+		require.Equal(t, strconv.FormatUint(block.Level, 10), block.Hash)
+		levels = append(levels, block.Level)
+	}
+	return levels, nil
+}
+
+func TestBlockRangeFetcher_OutOfOrder(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	api := newFakeBlockAPI()
+	// the first levels are the slowest, so they complete after the next ones
+	api.delay = func(level uint64) time.Duration {
+		return time.Duration(20-level) * time.Millisecond
+	}
+
+	fetcher := NewBlockRangeFetcher(api, WithWorkers(8), WithInFlight(8))
+	levels, err := collectLevels(t, fetcher.Blocks(t.Context(), 1, 16))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, levels)
+
+	api.mx.Lock()
+	require.False(t, slices.IsSorted(api.completed), "levels must complete out of order: %v", api.completed)
+	api.mx.Unlock()
+
+	requireNoLeak(t, before)
+}
+
+func TestBlockRangeFetcher_Retry(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	api := newFakeBlockAPI()
+	api.failures[5] = 2
+
+	fetcher := NewBlockRangeFetcher(api, WithRangeRetry(3, time.Millisecond, time.Millisecond))
+	levels, err := collectLevels(t, fetcher.Blocks(t.Context(), 1, 10))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, levels)
+	requireNoLeak(t, before)
+
+	api.mx.Lock()
+	require.Equal(t, 3, api.attempts[5])
+	require.Equal(t, 1, api.attempts[4])
+	api.mx.Unlock()
+}
+
+func TestBlockRangeFetcher_AttemptsExhausted(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	api := newFakeBlockAPI()
+	api.failures[5] = 3
+
+	fetcher := NewBlockRangeFetcher(api, WithRangeRetry(2, time.Millisecond, time.Millisecond))
+	var failed RangeBlock
+	levels := make([]uint64, 0)
+	for block, err := range fetcher.Blocks(t.Context(), 1, 10) {
+		if err != nil {
+			failed = block
+			break
+		}
+		levels = append(levels, block.Level)
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4}, levels)
+	require.EqualValues(t, 5, failed.Level)
+
+	requireNoLeak(t, before)
+}
+
+func TestBlockRangeFetcher_EarlyBreak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	api := newFakeBlockAPI()
+	api.delay = func(level uint64) time.Duration {
+		return time.Millisecond
+	}
+
+	fetcher := NewBlockRangeFetcher(api, WithWorkers(4), WithInFlight(4))
+	levels := make([]uint64, 0)
+	for block, err := range fetcher.Blocks(t.Context(), 1, 1000) {
+		require.NoError(t, err)
+		levels = append(levels, block.Level)
+		if len(levels) == 3 {
+			break
+		}
+	}
+	require.Equal(t, []uint64{1, 2, 3}, levels)
+
+	requireNoLeak(t, before)
+
+	// no levels are requested after break except ones which were in flight
+	api.mx.Lock()
+	require.LessOrEqual(t, len(api.attempts), 3+4)
+	api.mx.Unlock()
+}
+
+func TestBlockRangeFetcher_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	api := newFakeBlockAPI()
+	api.block = 3
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	fetcher := NewBlockRangeFetcher(api, WithWorkers(2), WithInFlight(2))
+	levels := make([]uint64, 0)
+	var resultErr error
+	for block, err := range fetcher.Blocks(ctx, 1, 100) {
+		if err != nil {
+			resultErr = err
+			break
+		}
+		levels = append(levels, block.Level)
+		if block.Level == 2 {
+			cancel()
+		}
+	}
+	require.Equal(t, []uint64{1, 2}, levels)
+	require.ErrorIs(t, resultErr, context.Canceled)
+
+	requireNoLeak(t, before)
+}
@@ -26,17 +26,17 @@ type Chain struct {
 }
 
 // NewChain -
-func NewChain(baseURL, chainID string) *Chain {
+func NewChain(baseURL, chainID string, opts ...ClientOption) *Chain {
 	return &Chain{
 		baseURL: strings.TrimSuffix(baseURL, "/"),
 		chainID: chainID,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
 // NewMainChain -
-func NewMainChain(baseURL string) *Chain {
-	return NewChain(baseURL, "main")
+func NewMainChain(baseURL string, opts ...ClientOption) *Chain {
+	return NewChain(baseURL, "main", opts...)
 }
 
 // ChainID -
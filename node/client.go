@@ -1,24 +1,252 @@
 package node
 
 import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// default retry parameters
+const (
+	DefaultRetryAttempts   = 3
+	DefaultRetryMinBackoff = 200 * time.Millisecond
+	DefaultRetryMaxBackoff = 10 * time.Second
 )
 
+type retryPolicy struct {
+	attempts   int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
 type client struct {
 	*http.Client
+
+	retry     retryPolicy
+	limiter   *rate.Limiter
+	observers []Observer
 }
 
-func newClient() *client {
+func newClient(opts ...ClientOption) *client {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.MaxIdleConns = 100
 	t.MaxConnsPerHost = 100
 	t.MaxIdleConnsPerHost = 100
 
-	return &client{
-		&http.Client{
+	c := &client{
+		Client: &http.Client{
 			Timeout:   time.Minute,
 			Transport: t,
 		},
+		retry: retryPolicy{
+			attempts: 1,
+		},
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+// ClientOption -
+type ClientOption func(*client)
+
+// WithTimeout - sets timeout of a single HTTP request. Default: 1 minute.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *client) {
+		if timeout > 0 {
+			c.Timeout = timeout
+		}
+	}
+}
+
+// WithTransport - replaces transport of HTTP client. Nil is ignored. Default: pooled `http.Transport`.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *client) {
+		if transport != nil {
+			c.Transport = transport
+		}
+	}
+}
+
+// WithRateLimit - limits requests per second with token bucket. The limiter is created once per option,
+// so all API groups built with the same option share the limit.
+func WithRateLimit(rps int) ClientOption {
+	if rps <= 0 {
+		return func(c *client) {}
+	}
+	limiter := rate.NewLimiter(rate.Limit(rps), rps)
+	return func(c *client) {
+		c.limiter = limiter
+	}
+}
+
+// WithRetry - retries requests failed with timeouts, reset or refused connections, 429 and 5xx statuses.
+// Non-idempotent requests (injection and operation simulation) are retried only if node didn't receive them: on refused connection, 429 and 503.
+// Delay between attempts grows exponentially from `minBackoff` to `maxBackoff` with random jitter.
+func WithRetry(attempts int, minBackoff, maxBackoff time.Duration) ClientOption {
+	return func(c *client) {
+		if attempts < 1 {
+			attempts = 1
+		}
+		if minBackoff <= 0 {
+			minBackoff = DefaultRetryMinBackoff
+		}
+		if maxBackoff < minBackoff {
+			maxBackoff = minBackoff
+		}
+		c.retry = retryPolicy{
+			attempts:   attempts,
+			minBackoff: minBackoff,
+			maxBackoff: maxBackoff,
+		}
+	}
+}
+
+// WithDefaultRetry - retries requests with default parameters
+func WithDefaultRetry() ClientOption {
+	return WithRetry(DefaultRetryAttempts, DefaultRetryMinBackoff, DefaultRetryMaxBackoff)
+}
+
+func (c *client) wait(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.Wait(ctx)
+}
+
+func (c *client) do(ctx context.Context, uri string, newRequest func(ctx context.Context) (*http.Request, error)) (*http.Response, error) {
+	endpoint, blockID := parseEndpoint(uri)
+
+	for attempt := 1; ; attempt++ {
+		if err := c.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		info := RequestInfo{
+			Path:     uri,
+			Endpoint: endpoint,
+			BlockID:  blockID,
+			Attempt:  attempt,
+		}
+		req, err := newRequest(ctx)
+		if err != nil {
+			return c.observe(ctx, info).finish(nil, err)
+		}
+		info.Node = req.URL.Host
+		info.Method = req.Method
+		info.RequestSize = req.ContentLength
+		idempotent := isIdempotent(req.Method, uri)
+
+		// observers receive filled request info and their context is used for the request
+		observation := c.observe(ctx, info)
+		req = req.WithContext(observation.ctx)
+
+		resp, err := c.Do(req) //nolint:gosec
+		resp, err = observation.finish(resp, err)
+		if attempt >= c.retry.attempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if !isRetryableError(err, idempotent) {
+				return nil, err
+			}
+		case isRetryableStatus(resp.StatusCode, idempotent):
+			delay = retryAfter(resp)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if delay == 0 {
+			delay = c.retry.backoff(attempt)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	delay := p.maxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if d := p.minBackoff << shift; d > 0 && d < p.maxBackoff {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + rand.N(half+1) //nolint:gosec
+}
+
+// isIdempotent - returns false for POST requests which change node state or may be processed by node twice: operation injection and simulation
+func isIdempotent(method, uri string) bool {
+	if method != http.MethodPost {
+		return true
+	}
+	return !strings.HasPrefix(uri, "injection/") &&
+		!strings.HasSuffix(uri, "helpers/scripts/run_operation") &&
+		!strings.HasSuffix(uri, "helpers/scripts/simulate_operation")
+}
+
+// isRetryableStatus - non-idempotent requests are retried only on statuses which mean that request wasn't processed
+func isRetryableStatus(code int, idempotent bool) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// isRetryableError - every error of `http.Client.Do` implements `net.Error`, so only timeouts and connection errors are retried.
+// Non-idempotent requests are retried only if connection wasn't established, i.e. request wasn't written.
+func isRetryableError(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	retryable := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
+	if !retryable || idempotent {
+		return retryable
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
 	}
+	return 0
 }
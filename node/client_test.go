@@ -0,0 +1,209 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func urlError(err error) error {
+	return &url.Error{Op: "Post", URL: "http://localhost:8732/injection/operation", Err: err}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               error
+		wantIdempotent    bool
+		wantNonIdempotent bool
+	}{
+		{
+			name: "url error without timeout",
+			err:  urlError(errors.New("unsupported protocol scheme")),
+		}, {
+			name:           "timeout",
+			err:            urlError(timeoutError{}),
+			wantIdempotent: true,
+		}, {
+			name:           "connection reset",
+			err:            urlError(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}),
+			wantIdempotent: true,
+		}, {
+			name:              "connection refused",
+			err:               urlError(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}),
+			wantIdempotent:    true,
+			wantNonIdempotent: true,
+		}, {
+			name:              "dial timeout",
+			err:               urlError(&net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}),
+			wantIdempotent:    true,
+			wantNonIdempotent: true,
+		}, {
+			name:           "EOF",
+			err:            urlError(io.EOF),
+			wantIdempotent: true,
+		}, {
+			name:           "unexpected EOF",
+			err:            urlError(io.ErrUnexpectedEOF),
+			wantIdempotent: true,
+		}, {
+			name: "canceled",
+			err:  urlError(context.Canceled),
+		}, {
+			name: "dns error",
+			err:  urlError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "node", IsNotFound: true}}),
+		}, {
+			name: "tls error",
+			err:  urlError(errors.New("tls: failed to verify certificate")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantIdempotent, isRetryableError(tt.err, true), "idempotent")
+			require.Equal(t, tt.wantNonIdempotent, isRetryableError(tt.err, false), "non-idempotent")
+		})
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		code              int
+		wantIdempotent    bool
+		wantNonIdempotent bool
+	}{
+		{code: http.StatusOK},
+		{code: http.StatusBadRequest},
+		{code: http.StatusNotFound},
+		{code: http.StatusTooManyRequests, wantIdempotent: true, wantNonIdempotent: true},
+		{code: http.StatusInternalServerError, wantIdempotent: true},
+		{code: http.StatusBadGateway, wantIdempotent: true},
+		{code: http.StatusServiceUnavailable, wantIdempotent: true, wantNonIdempotent: true},
+		{code: http.StatusGatewayTimeout, wantIdempotent: true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.code), func(t *testing.T) {
+			require.Equal(t, tt.wantIdempotent, isRetryableStatus(tt.code, true), "idempotent")
+			require.Equal(t, tt.wantNonIdempotent, isRetryableStatus(tt.code, false), "non-idempotent")
+		})
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		uri    string
+		want   bool
+	}{
+		{method: http.MethodGet, uri: "chains/main/blocks/head/header", want: true},
+		{method: http.MethodPost, uri: "chains/main/blocks/head/helpers/scripts/run_view", want: true},
+		{method: http.MethodPost, uri: "chains/main/blocks/head/helpers/forge/operations", want: true},
+		{method: http.MethodPost, uri: "injection/operation"},
+		{method: http.MethodPost, uri: "chains/main/blocks/head/helpers/scripts/run_operation"},
+		{method: http.MethodPost, uri: "chains/main/blocks/head/helpers/scripts/simulate_operation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.uri, func(t *testing.T) {
+			require.Equal(t, tt.want, isIdempotent(tt.method, tt.uri))
+		})
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		call      func(ctx context.Context, baseURL string, opts ...ClientOption) error
+		wantCalls int32
+	}{
+		{
+			name:   "GET is retried on 500",
+			status: http.StatusInternalServerError,
+			call: func(ctx context.Context, baseURL string, opts ...ClientOption) error {
+				_, err := NewMainChain(baseURL, opts...).ChainID(ctx)
+				return err
+			},
+			wantCalls: 2,
+		}, {
+			name:   "injection is not retried on 500",
+			status: http.StatusInternalServerError,
+			call: func(ctx context.Context, baseURL string, opts ...ClientOption) error {
+				_, err := NewInject(baseURL, opts...).InjectOperation(ctx, InjectOperationRequest{Operation: "00"})
+				return err
+			},
+			wantCalls: 1,
+		}, {
+			name:   "injection is retried on 503",
+			status: http.StatusServiceUnavailable,
+			call: func(ctx context.Context, baseURL string, opts ...ClientOption) error {
+				_, err := NewInject(baseURL, opts...).InjectOperation(ctx, InjectOperationRequest{Operation: "00"})
+				return err
+			},
+			wantCalls: 2,
+		}, {
+			name:   "run_operation is not retried on 502",
+			status: http.StatusBadGateway,
+			call: func(ctx context.Context, baseURL string, opts ...ClientOption) error {
+				_, err := NewMainHelpers(baseURL, opts...).RunOperation(ctx, HeadBlock, RunOperationRequest{})
+				return err
+			},
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`"NetXdQprcVkpaWU"`))
+			}))
+			defer server.Close()
+
+			err := tt.call(t.Context(), server.URL, WithRetry(3, time.Millisecond, time.Millisecond))
+			require.Equal(t, tt.wantCalls, calls.Load())
+			if tt.wantCalls == 1 {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_RetryAfterWrite(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		// connection is closed after request was received
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		conn.Close()
+	}))
+	defer server.Close()
+
+	_, err := NewInject(server.URL, WithRetry(3, time.Millisecond, time.Millisecond)).InjectOperation(t.Context(), InjectOperationRequest{Operation: "00"})
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls.Load())
+
+	calls.Store(0)
+	_, err = NewMainChain(server.URL, WithRetry(3, time.Millisecond, time.Millisecond)).ChainID(t.Context())
+	require.Error(t, err)
+	require.EqualValues(t, 3, calls.Load())
+}
@@ -16,10 +16,10 @@ type Config struct {
 }
 
 // NewConfig -
-func NewConfig(baseURL string) *Config {
+func NewConfig(baseURL string, opts ...ClientOption) *Config {
 	return &Config{
 		baseURL: baseURL,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
@@ -34,7 +34,7 @@ const (
 	KindTransferTicket             = "transfer_ticket"
 	KindEvent                      = "event"
 	KindVdfRevelation              = "vdf_revelation"
-	KindIncreasePaidStorage        = "Increase_paid_storage"
+	KindIncreasePaidStorage        = "increase_paid_storage"
 	KindUpdateConsensusKey         = "update_consensus_key"
 	KindDrainDelegate              = "drain_delegate"
 	KindSrAddMessages              = "smart_rollup_add_messages"
@@ -48,6 +48,22 @@ const (
 	KindDalPublishCommitment       = "dal_publish_commitment"
 )
 
+// kinds of consensus operations renamed in Oxford. They are decoded to the same models as endorsements.
+const (
+	KindAttestation          = "attestation"
+	KindAttestationWithDal   = "attestation_with_dal"
+	KindPreattestation       = "preattestation"
+	KindDoubleAttestation    = "double_attestation_evidence"
+	KindDoublePreattestation = "double_preattestation_evidence"
+)
+
+const (
+	OperationStatusApplied     = "applied"
+	OperationStatusFailed      = "failed"
+	OperationStatusBacktracked = "backtracked"
+	OperationStatusSkipped     = "skipped"
+)
+
 const (
 	BigMapActionUpdate = "update"
 	BigMapActionRemove = "remove"
@@ -4,6 +4,7 @@ import (
 	"context"
 	stdJSON "encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -22,6 +23,7 @@ type ContextAPI interface {
 	ContractBalance(ctx context.Context, blockID, contract string) (string, error)
 	ContractCounter(ctx context.Context, blockID, contract string) (string, error)
 	ContractDelegate(ctx context.Context, blockID, contract string) (string, error)
+	ContractManagerKey(ctx context.Context, blockID, contract string) (string, error)
 	ContractEntrypoints(ctx context.Context, blockID, contract string) (Entrypoints, error)
 	ContractEntrypoint(ctx context.Context, blockID, contract, entrypoint string) (stdJSON.RawMessage, error)
 	ContractScript(ctx context.Context, blockID, contract string) (Script, error)
@@ -40,6 +42,28 @@ type ContextAPI interface {
 	TxRollupCommitment(ctx context.Context, blockID, txRollupID, blockLevel string) (*RollupCommitmentForBlock, error)
 	TxRollupInbox(ctx context.Context, blockID, txRollupID, blockLevel string) (*TxRollupInbox, error)
 	TxRollupPendingBondedCommitments(ctx context.Context, blockID, txRollupID, pkh string) (uint64, error)
+	DelegateStakers(ctx context.Context, blockID, pkh string) ([]DelegateStaker, error)
+	DelegateActiveStakingParameters(ctx context.Context, blockID, pkh string) (StakingParameters, error)
+	DelegatePendingStakingParameters(ctx context.Context, blockID, pkh string) ([]PendingStakingParameters, error)
+	DelegateFullBalance(ctx context.Context, blockID, pkh string) (string, error)
+	DelegateConsensusKey(ctx context.Context, blockID, pkh string) (ConsensusKeys, error)
+	ContractFullBalance(ctx context.Context, blockID, contract string) (string, error)
+	ContractStakedBalance(ctx context.Context, blockID, contract string) (string, error)
+	ContractUnstakedFrozenBalance(ctx context.Context, blockID, contract string) (string, error)
+	ContractUnstakedFinalizableBalance(ctx context.Context, blockID, contract string) (string, error)
+	ContractUnstakeRequests(ctx context.Context, blockID, contract string) (*UnstakeRequests, error)
+	ContractAllTicketBalances(ctx context.Context, blockID, contract string) ([]TicketBalance, error)
+	ContractTicketBalance(ctx context.Context, blockID, contract string, ticket Ticket) (string, error)
+	SmartRollups(ctx context.Context, blockID string) ([]string, error)
+	SmartRollupInbox(ctx context.Context, blockID string) (SmartRollupInbox, error)
+	SmartRollupKind(ctx context.Context, blockID, address string) (string, error)
+	SmartRollupGenesisInfo(ctx context.Context, blockID, address string) (SmartRollupGenesisInfo, error)
+	SmartRollupLastCementedCommitment(ctx context.Context, blockID, address string) (SmartRollupCementedCommitment, error)
+	SmartRollupCommitment(ctx context.Context, blockID, address, hash string) (SrCommitmentInfo, error)
+	SmartRollupStakedOnCommitment(ctx context.Context, blockID, address, staker string) (*SmartRollupStakedCommitment, error)
+	SmartRollupWhitelist(ctx context.Context, blockID, address string) ([]string, error)
+	DalParameters(ctx context.Context, blockID string) (DalParametric, error)
+	DalShards(ctx context.Context, blockID string, delegates ...string) ([]DalShards, error)
 }
 
 // Context -
@@ -50,17 +74,17 @@ type Context struct {
 }
 
 // NewContext -
-func NewContext(baseURL, chainID string) *Context {
+func NewContext(baseURL, chainID string, opts ...ClientOption) *Context {
 	return &Context{
 		baseURL: strings.TrimSuffix(baseURL, "/"),
 		chainID: chainID,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
 // NewMainContext -
-func NewMainContext(baseURL string) *Context {
-	return NewContext(baseURL, "main")
+func NewMainContext(baseURL string, opts ...ClientOption) *Context {
+	return NewContext(baseURL, "main", opts...)
 }
 
 // BigMap -
@@ -159,6 +183,22 @@ func (api *Context) ContractCounter(ctx context.Context, blockID, contract strin
 	return result, err
 }
 
+// ContractManagerKey - returns revealed public key of implicit account. It returns empty string if key is not revealed.
+func (api *Context) ContractManagerKey(ctx context.Context, blockID, contract string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/manager_key", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return "", err
+	}
+	var result *string
+	if err := req.doWithJSONResponse(ctx, api.client, &result); err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", nil
+	}
+	return *result, nil
+}
+
 // ContractDelegate -
 func (api *Context) ContractDelegate(ctx context.Context, blockID, contract string) (string, error) {
 	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/delegate", api.chainID, blockID, contract), nil)
@@ -367,3 +407,250 @@ func (api *Context) TxRollupPendingBondedCommitments(ctx context.Context, blockI
 	err = req.doWithJSONResponse(ctx, api.client, &response)
 	return response, err
 }
+
+// DelegateStakers - returns stakers of the delegate with their frozen deposits
+func (api *Context) DelegateStakers(ctx context.Context, blockID, pkh string) ([]DelegateStaker, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/delegates/%s/stakers", api.chainID, blockID, pkh), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []DelegateStaker
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// DelegateActiveStakingParameters -
+func (api *Context) DelegateActiveStakingParameters(ctx context.Context, blockID, pkh string) (StakingParameters, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/delegates/%s/active_staking_parameters", api.chainID, blockID, pkh), nil)
+	if err != nil {
+		return StakingParameters{}, err
+	}
+	var result StakingParameters
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// DelegatePendingStakingParameters -
+func (api *Context) DelegatePendingStakingParameters(ctx context.Context, blockID, pkh string) ([]PendingStakingParameters, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/delegates/%s/pending_staking_parameters", api.chainID, blockID, pkh), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []PendingStakingParameters
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// DelegateFullBalance - returns full balance of the delegate including frozen deposits and unstaked tokens
+func (api *Context) DelegateFullBalance(ctx context.Context, blockID, pkh string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/delegates/%s/full_balance", api.chainID, blockID, pkh), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// DelegateConsensusKey - returns active and pending consensus keys of the delegate
+func (api *Context) DelegateConsensusKey(ctx context.Context, blockID, pkh string) (ConsensusKeys, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/delegates/%s/consensus_key", api.chainID, blockID, pkh), nil)
+	if err != nil {
+		return ConsensusKeys{}, err
+	}
+	var result ConsensusKeys
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractFullBalance -
+func (api *Context) ContractFullBalance(ctx context.Context, blockID, contract string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/full_balance", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractStakedBalance -
+func (api *Context) ContractStakedBalance(ctx context.Context, blockID, contract string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/staked_balance", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractUnstakedFrozenBalance -
+func (api *Context) ContractUnstakedFrozenBalance(ctx context.Context, blockID, contract string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/unstaked_frozen_balance", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractUnstakedFinalizableBalance -
+func (api *Context) ContractUnstakedFinalizableBalance(ctx context.Context, blockID, contract string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/unstaked_finalizable_balance", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractUnstakeRequests - returns nil if the contract has no unstake requests
+func (api *Context) ContractUnstakeRequests(ctx context.Context, blockID, contract string) (*UnstakeRequests, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/unstake_requests", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result *UnstakeRequests
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractAllTicketBalances -
+func (api *Context) ContractAllTicketBalances(ctx context.Context, blockID, contract string) ([]TicketBalance, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/all_ticket_balances", api.chainID, blockID, contract), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []TicketBalance
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollups - returns addresses of all smart rollups
+func (api *Context) SmartRollups(ctx context.Context, blockID string) ([]string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/all", api.chainID, blockID), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupInbox -
+func (api *Context) SmartRollupInbox(ctx context.Context, blockID string) (SmartRollupInbox, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/all/inbox", api.chainID, blockID), nil)
+	if err != nil {
+		return SmartRollupInbox{}, err
+	}
+	var result SmartRollupInbox
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupKind -
+func (api *Context) SmartRollupKind(ctx context.Context, blockID, address string) (string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/kind", api.chainID, blockID, address), nil)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupGenesisInfo -
+func (api *Context) SmartRollupGenesisInfo(ctx context.Context, blockID, address string) (SmartRollupGenesisInfo, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/genesis_info", api.chainID, blockID, address), nil)
+	if err != nil {
+		return SmartRollupGenesisInfo{}, err
+	}
+	var result SmartRollupGenesisInfo
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupLastCementedCommitment - returns hash and level of the last cemented commitment
+func (api *Context) SmartRollupLastCementedCommitment(ctx context.Context, blockID, address string) (SmartRollupCementedCommitment, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/last_cemented_commitment_hash_with_level", api.chainID, blockID, address), nil)
+	if err != nil {
+		return SmartRollupCementedCommitment{}, err
+	}
+	var result SmartRollupCementedCommitment
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupCommitment -
+func (api *Context) SmartRollupCommitment(ctx context.Context, blockID, address, hash string) (SrCommitmentInfo, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/commitment/%s", api.chainID, blockID, address, hash), nil)
+	if err != nil {
+		return SrCommitmentInfo{}, err
+	}
+	var result SrCommitmentInfo
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupStakedOnCommitment - returns nil if the staker is not staked
+func (api *Context) SmartRollupStakedOnCommitment(ctx context.Context, blockID, address, staker string) (*SmartRollupStakedCommitment, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/staker/%s/staked_on_commitment", api.chainID, blockID, address, staker), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result *SmartRollupStakedCommitment
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SmartRollupWhitelist - returns nil for public rollups
+func (api *Context) SmartRollupWhitelist(ctx context.Context, blockID, address string) ([]string, error) {
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/smart_rollups/smart_rollup/%s/whitelist", api.chainID, blockID, address), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// ContractTicketBalance - returns balance of the ticket owned by the contract
+func (api *Context) ContractTicketBalance(ctx context.Context, blockID, contract string, ticket Ticket) (string, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/contracts/%s/ticket_balance", api.chainID, blockID, contract), nil, ticket)
+	if err != nil {
+		return "", err
+	}
+	var result string
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// DalParameters - returns DAL parameters from protocol constants
+func (api *Context) DalParameters(ctx context.Context, blockID string) (DalParametric, error) {
+	constants, err := api.Constants(ctx, blockID)
+	if err != nil {
+		return DalParametric{}, err
+	}
+	if constants.DalParametric == nil {
+		return DalParametric{}, errors.Errorf("DAL parameters are absent at %s", blockID)
+	}
+	return *constants.DalParametric, nil
+}
+
+// DalShards - returns DAL shards assigned to delegates for attestations. If `delegates` is empty, shards of all delegates are returned.
+func (api *Context) DalShards(ctx context.Context, blockID string, delegates ...string) ([]DalShards, error) {
+	query := make(url.Values)
+	for i := range delegates {
+		query.Add("delegates", delegates[i])
+	}
+	req, err := newGetRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/context/dal/shards", api.chainID, blockID), query)
+	if err != nil {
+		return nil, err
+	}
+	var result []DalShards
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
@@ -0,0 +1,137 @@
+package node
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext_Requests(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		method   string
+		path     string
+		query    string
+		body     string
+		call     func(t *testing.T, api *Context)
+	}{
+		{
+			name:     "unstake requests null",
+			response: `null`,
+			method:   http.MethodGet,
+			path:     "/chains/main/blocks/head/context/contracts/tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm/unstake_requests",
+			call: func(t *testing.T, api *Context) {
+				requests, err := api.ContractUnstakeRequests(t.Context(), HeadBlock, "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm")
+				require.NoError(t, err)
+				require.Nil(t, requests)
+			},
+		}, {
+			name:     "unstake requests",
+			response: `{"finalizable":[{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","cycle":750,"amount":"100"}],"unfinalizable":{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","requests":[{"cycle":752,"amount":"200"}]}}`,
+			method:   http.MethodGet,
+			path:     "/chains/main/blocks/head/context/contracts/tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm/unstake_requests",
+			call: func(t *testing.T, api *Context) {
+				requests, err := api.ContractUnstakeRequests(t.Context(), HeadBlock, "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm")
+				require.NoError(t, err)
+				require.Equal(t, &UnstakeRequests{
+					Finalizable: []FinalizableUnstakeRequest{
+						{Delegate: "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", Cycle: 750, Amount: "100"},
+					},
+					Unfinalizable: UnfinalizableUnstakeRequest{
+						Delegate: "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk",
+						Requests: []UnstakeAmount{{Cycle: 752, Amount: "200"}},
+					},
+				}, requests)
+			},
+		}, {
+			name:     "consensus keys with pendings",
+			response: `{"active":{"pkh":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","pk":"edpkActive"},"pendings":[{"cycle":751,"pkh":"tz4A8vsT22iNv8ViCWaZwM8qVTGafb1SRCY3","pk":"BLpkPending"},{"cycle":752,"pkh":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","pk":"edpkPending"}]}`,
+			method:   http.MethodGet,
+			path:     "/chains/main/blocks/123/context/delegates/tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk/consensus_key",
+			call: func(t *testing.T, api *Context) {
+				keys, err := api.DelegateConsensusKey(t.Context(), "123", "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk")
+				require.NoError(t, err)
+				require.Equal(t, ConsensusKeys{
+					Active: ConsensusKey{Pkh: "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", Pk: "edpkActive"},
+					Pendings: []PendingConsensusKey{
+						{Cycle: 751, ConsensusKey: ConsensusKey{Pkh: "tz4A8vsT22iNv8ViCWaZwM8qVTGafb1SRCY3", Pk: "BLpkPending"}},
+						{Cycle: 752, ConsensusKey: ConsensusKey{Pkh: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm", Pk: "edpkPending"}},
+					},
+				}, keys)
+			},
+		}, {
+			name:     "dal shards of all delegates",
+			response: `[{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","indexes":[0,5,7]}]`,
+			method:   http.MethodGet,
+			path:     "/chains/main/blocks/head/context/dal/shards",
+			call: func(t *testing.T, api *Context) {
+				shards, err := api.DalShards(t.Context(), HeadBlock)
+				require.NoError(t, err)
+				require.Equal(t, []DalShards{
+					{Delegate: "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", Indexes: []uint64{0, 5, 7}},
+				}, shards)
+			},
+		}, {
+			name:     "dal shards of delegates",
+			response: `[]`,
+			method:   http.MethodGet,
+			path:     "/chains/main/blocks/head/context/dal/shards",
+			query:    "delegates=tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk&delegates=tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+			call: func(t *testing.T, api *Context) {
+				shards, err := api.DalShards(t.Context(), HeadBlock, "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm")
+				require.NoError(t, err)
+				require.Empty(t, shards)
+			},
+		}, {
+			name:     "ticket balance",
+			response: `"42"`,
+			method:   http.MethodPost,
+			path:     "/chains/main/blocks/head/context/contracts/KT1Owner/ticket_balance",
+			body:     `{"ticketer":"KT1Ticketer","content_type":{"prim":"string"},"content":{"string":"ticket"}}`,
+			call: func(t *testing.T, api *Context) {
+				balance, err := api.ContractTicketBalance(t.Context(), HeadBlock, "KT1Owner", Ticket{
+					Ticketer:    "KT1Ticketer",
+					ContentType: []byte(`{"prim":"string"}`),
+					Content:     []byte(`{"string":"ticket"}`),
+				})
+				require.NoError(t, err)
+				require.Equal(t, "42", balance)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				method, path, query string
+				body                []byte
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method = r.Method
+				path = r.URL.Path
+				query = r.URL.RawQuery
+
+				data, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				body = data
+
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			tt.call(t, NewMainContext(server.URL))
+
+			require.Equal(t, tt.method, method)
+			require.Equal(t, tt.path, path)
+			require.Equal(t, tt.query, query)
+			if tt.body == "" {
+				require.Empty(t, body)
+			} else {
+				require.JSONEq(t, tt.body, string(body))
+			}
+		})
+	}
+}
@@ -39,6 +39,20 @@ type Constants struct {
 	InitialEndorsers             int64            `json:"initial_endorsers"`
 	DelayPerMissingEndorsement   int64            `json:"delay_per_missing_endorsement,string"`
 	MinimalBlockDelay            int64            `json:"minimal_block_delay,string,omitempty"`
+	DalParametric                *DalParametric   `json:"dal_parametric,omitempty"`
+}
+
+// DalParametric - parameters of data availability layer
+type DalParametric struct {
+	FeatureEnable        bool   `json:"feature_enable"`
+	IncentivesEnable     bool   `json:"incentives_enable"`
+	NumberOfSlots        uint64 `json:"number_of_slots"`
+	AttestationLag       uint64 `json:"attestation_lag"`
+	AttestationThreshold uint64 `json:"attestation_threshold"`
+	RedundancyFactor     uint64 `json:"redundancy_factor"`
+	PageSize             uint64 `json:"page_size"`
+	SlotSize             uint64 `json:"slot_size"`
+	NumberOfShards       uint64 `json:"number_of_shards"`
 }
 
 // Int64StringSlice -
@@ -171,3 +185,107 @@ type TxRollupInbox struct {
 	CumulatedSize uint64 `json:"cumulated_size"`
 	MerkleRoot    string `json:"merkle_root"`
 }
+
+// DelegateStaker - staker of delegate and its frozen deposits
+type DelegateStaker struct {
+	Staker         string `json:"staker"`
+	FrozenDeposits string `json:"frozen_deposits"`
+}
+
+// StakingParameters -
+type StakingParameters struct {
+	LimitOfStakingOverBakingMillionth uint64 `json:"limit_of_staking_over_baking_millionth"`
+	EdgeOfBakingOverStakingBillionth  uint64 `json:"edge_of_baking_over_staking_billionth"`
+}
+
+// PendingStakingParameters - staking parameters which will be activated in the cycle
+type PendingStakingParameters struct {
+	Cycle      uint64            `json:"cycle"`
+	Parameters StakingParameters `json:"parameters"`
+}
+
+// UnstakeRequests -
+type UnstakeRequests struct {
+	Finalizable   []FinalizableUnstakeRequest `json:"finalizable"`
+	Unfinalizable UnfinalizableUnstakeRequest `json:"unfinalizable"`
+}
+
+// FinalizableUnstakeRequest -
+type FinalizableUnstakeRequest struct {
+	Delegate string `json:"delegate"`
+	Cycle    uint64 `json:"cycle"`
+	Amount   string `json:"amount"`
+}
+
+// UnfinalizableUnstakeRequest -
+type UnfinalizableUnstakeRequest struct {
+	Delegate string          `json:"delegate"`
+	Requests []UnstakeAmount `json:"requests"`
+}
+
+// UnstakeAmount -
+type UnstakeAmount struct {
+	Cycle  uint64 `json:"cycle"`
+	Amount string `json:"amount"`
+}
+
+// ConsensusKeys - active and pending consensus keys of delegate
+type ConsensusKeys struct {
+	Active   ConsensusKey          `json:"active"`
+	Pendings []PendingConsensusKey `json:"pendings"`
+}
+
+// ConsensusKey -
+type ConsensusKey struct {
+	Pkh string `json:"pkh"`
+	Pk  string `json:"pk"`
+}
+
+// PendingConsensusKey -
+type PendingConsensusKey struct {
+	Cycle uint64 `json:"cycle"`
+	ConsensusKey
+}
+
+// SmartRollupGenesisInfo -
+type SmartRollupGenesisInfo struct {
+	Level          uint64 `json:"level"`
+	CommitmentHash string `json:"commitment_hash"`
+}
+
+// SmartRollupCementedCommitment -
+type SmartRollupCementedCommitment struct {
+	Hash  string `json:"hash"`
+	Level uint64 `json:"level"`
+}
+
+// SmartRollupStakedCommitment - commitment on which staker is staked
+type SmartRollupStakedCommitment struct {
+	Hash       string           `json:"hash"`
+	Commitment SrCommitmentInfo `json:"commitment"`
+}
+
+// SmartRollupInbox - inbox of all smart rollups
+type SmartRollupInbox struct {
+	Level             uint64             `json:"level"`
+	OldLevelsMessages stdJSON.RawMessage `json:"old_levels_messages"`
+}
+
+// DalShards - DAL shards assigned to the delegate
+type DalShards struct {
+	Delegate string   `json:"delegate"`
+	Indexes  []uint64 `json:"indexes"`
+}
+
+// Ticket - ticket identifier: ticketer contract and typed content
+type Ticket struct {
+	Ticketer    string             `json:"ticketer"`
+	ContentType stdJSON.RawMessage `json:"content_type"`
+	Content     stdJSON.RawMessage `json:"content"`
+}
+
+// TicketBalance -
+type TicketBalance struct {
+	Ticket
+	Amount string `json:"amount"`
+}
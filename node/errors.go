@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -25,3 +26,58 @@ func (e RequestError) Error() string {
 	}
 	return fmt.Sprintf("%s | status code: %d", e.Body, e.Code)
 }
+
+// ProtocolErrors - returns errors of the body if node rejected request with the list of protocol errors, e.g. on injection or simulation of invalid operation. Otherwise it returns nil.
+func (e RequestError) ProtocolErrors() []ResultError {
+	body := strings.TrimSpace(e.Body)
+	if !strings.HasPrefix(body, "[") {
+		return nil
+	}
+	var errs []ResultError
+	if err := json.Unmarshal([]byte(body), &errs); err != nil {
+		return nil
+	}
+	for i := range errs {
+		if errs[i].Kind == "" || errs[i].ID == "" {
+			return nil
+		}
+	}
+	return errs
+}
+
+// DecodeError - error of decoding node response to the model
+type DecodeError struct {
+	Err error
+}
+
+// Error -
+func (e DecodeError) Error() string {
+	return fmt.Sprintf("response decoding: %s", e.Err.Error())
+}
+
+// Unwrap -
+func (e DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// PoolError - error returned by `Pool` when all endpoints failed
+type PoolError struct {
+	Errors []error
+}
+
+// Error -
+func (e PoolError) Error() string {
+	if len(e.Errors) == 0 {
+		return "all endpoints failed"
+	}
+	messages := make([]string, len(e.Errors))
+	for i := range e.Errors {
+		messages[i] = e.Errors[i].Error()
+	}
+	return fmt.Sprintf("all endpoints failed: %s", strings.Join(messages, "; "))
+}
+
+// Unwrap -
+func (e PoolError) Unwrap() []error {
+	return e.Errors
+}
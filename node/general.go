@@ -18,10 +18,10 @@ type General struct {
 }
 
 // NewGeneral -
-func NewGeneral(baseURL string) *General {
+func NewGeneral(baseURL string, opts ...ClientOption) *General {
 	return &General{
 		baseURL: baseURL,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
@@ -0,0 +1,175 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// HelpersAPI -
+type HelpersAPI interface {
+	RunOperation(ctx context.Context, blockID string, request RunOperationRequest) (RunOperationResponse, error)
+	SimulateOperation(ctx context.Context, blockID string, request SimulateOperationRequest) (RunOperationResponse, error)
+	PreapplyOperations(ctx context.Context, blockID string, operations []PreapplyOperation) ([]RunOperationResponse, error)
+	RunCode(ctx context.Context, blockID string, request RunCodeRequest) (RunCodeResponse, error)
+	TraceCode(ctx context.Context, blockID string, request RunCodeRequest) (TraceCodeResponse, error)
+	RunView(ctx context.Context, blockID string, request RunViewRequest) (RunViewResponse, error)
+	RunScriptView(ctx context.Context, blockID string, request RunScriptViewRequest) (RunViewResponse, error)
+	TypecheckCode(ctx context.Context, blockID string, request TypecheckCodeRequest) (TypecheckCodeResponse, error)
+	TypecheckData(ctx context.Context, blockID string, request TypecheckDataRequest) (TypecheckDataResponse, error)
+	PackData(ctx context.Context, blockID string, request PackDataRequest) (PackDataResponse, error)
+	NormalizeData(ctx context.Context, blockID string, request NormalizeDataRequest) (NormalizeDataResponse, error)
+}
+
+// Helpers -
+type Helpers struct {
+	baseURL string
+	chainID string
+	client  *client
+}
+
+// NewHelpers -
+func NewHelpers(baseURL, chainID string, opts ...ClientOption) *Helpers {
+	return &Helpers{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		chainID: chainID,
+		client:  newClient(opts...),
+	}
+}
+
+// NewMainHelpers -
+func NewMainHelpers(baseURL string, opts ...ClientOption) *Helpers {
+	return NewHelpers(baseURL, "main", opts...)
+}
+
+// RunOperation - runs operation without signature checks and returns its receipt
+func (api *Helpers) RunOperation(ctx context.Context, blockID string, request RunOperationRequest) (RunOperationResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/run_operation", api.chainID, blockID), nil, request)
+	if err != nil {
+		return RunOperationResponse{}, err
+	}
+	var result RunOperationResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// SimulateOperation - simulates operation in the future block and returns its receipt
+func (api *Helpers) SimulateOperation(ctx context.Context, blockID string, request SimulateOperationRequest) (RunOperationResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/simulate_operation", api.chainID, blockID), nil, request)
+	if err != nil {
+		return RunOperationResponse{}, err
+	}
+	var result RunOperationResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// PreapplyOperations - applies signed operations in the context of the block without injection
+func (api *Helpers) PreapplyOperations(ctx context.Context, blockID string, operations []PreapplyOperation) ([]RunOperationResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/preapply/operations", api.chainID, blockID), nil, operations)
+	if err != nil {
+		return nil, err
+	}
+	var result []RunOperationResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// RunCode - runs script with given storage and input
+func (api *Helpers) RunCode(ctx context.Context, blockID string, request RunCodeRequest) (RunCodeResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/run_code", api.chainID, blockID), nil, request)
+	if err != nil {
+		return RunCodeResponse{}, err
+	}
+	var result RunCodeResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// TraceCode - runs script and returns stack after every instruction
+func (api *Helpers) TraceCode(ctx context.Context, blockID string, request RunCodeRequest) (TraceCodeResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/trace_code", api.chainID, blockID), nil, request)
+	if err != nil {
+		return TraceCodeResponse{}, err
+	}
+	var result TraceCodeResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// RunView - calls TZIP-4 view. Readable unparsing mode is used if it's not set.
+func (api *Helpers) RunView(ctx context.Context, blockID string, request RunViewRequest) (RunViewResponse, error) {
+	request.UnparsingMode = unparsingModeOrDefault(request.UnparsingMode)
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/run_view", api.chainID, blockID), nil, request)
+	if err != nil {
+		return RunViewResponse{}, err
+	}
+	var result RunViewResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// RunScriptView - calls on-chain view. Readable unparsing mode is used if it's not set.
+func (api *Helpers) RunScriptView(ctx context.Context, blockID string, request RunScriptViewRequest) (RunViewResponse, error) {
+	request.UnparsingMode = unparsingModeOrDefault(request.UnparsingMode)
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/run_script_view", api.chainID, blockID), nil, request)
+	if err != nil {
+		return RunViewResponse{}, err
+	}
+	var result RunViewResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// TypecheckCode -
+func (api *Helpers) TypecheckCode(ctx context.Context, blockID string, request TypecheckCodeRequest) (TypecheckCodeResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/typecheck_code", api.chainID, blockID), nil, request)
+	if err != nil {
+		return TypecheckCodeResponse{}, err
+	}
+	var result TypecheckCodeResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// TypecheckData -
+func (api *Helpers) TypecheckData(ctx context.Context, blockID string, request TypecheckDataRequest) (TypecheckDataResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/typecheck_data", api.chainID, blockID), nil, request)
+	if err != nil {
+		return TypecheckDataResponse{}, err
+	}
+	var result TypecheckDataResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// PackData -
+func (api *Helpers) PackData(ctx context.Context, blockID string, request PackDataRequest) (PackDataResponse, error) {
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/pack_data", api.chainID, blockID), nil, request)
+	if err != nil {
+		return PackDataResponse{}, err
+	}
+	var result PackDataResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// NormalizeData - normalizes data. Readable unparsing mode is used if it's not set.
+func (api *Helpers) NormalizeData(ctx context.Context, blockID string, request NormalizeDataRequest) (NormalizeDataResponse, error) {
+	request.UnparsingMode = unparsingModeOrDefault(request.UnparsingMode)
+	req, err := newPostRequest(api.baseURL, fmt.Sprintf("chains/%s/blocks/%s/helpers/scripts/normalize_data", api.chainID, blockID), nil, request)
+	if err != nil {
+		return NormalizeDataResponse{}, err
+	}
+	var result NormalizeDataResponse
+	err = req.doWithJSONResponse(ctx, api.client, &result)
+	return result, err
+}
+
+// unparsingModeOrDefault - unparsing mode is required by node, so readable mode is used if it's empty
+func unparsingModeOrDefault(mode string) string {
+	if mode == "" {
+		return UnparsingModeReadable
+	}
+	return mode
+}
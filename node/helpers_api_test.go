@@ -0,0 +1,65 @@
+package node
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelpers_UnparsingMode(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(api *Helpers) error
+		want string
+	}{
+		{
+			name: "run_view default",
+			call: func(api *Helpers) error {
+				_, err := api.RunView(t.Context(), HeadBlock, RunViewRequest{})
+				return err
+			},
+			want: UnparsingModeReadable,
+		}, {
+			name: "run_view optimized",
+			call: func(api *Helpers) error {
+				_, err := api.RunView(t.Context(), HeadBlock, RunViewRequest{UnparsingMode: UnparsingModeOptimized})
+				return err
+			},
+			want: UnparsingModeOptimized,
+		}, {
+			name: "run_script_view default",
+			call: func(api *Helpers) error {
+				_, err := api.RunScriptView(t.Context(), HeadBlock, RunScriptViewRequest{})
+				return err
+			},
+			want: UnparsingModeReadable,
+		}, {
+			name: "normalize_data default",
+			call: func(api *Helpers) error {
+				_, err := api.NormalizeData(t.Context(), HeadBlock, NormalizeDataRequest{})
+				return err
+			},
+			want: UnparsingModeReadable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				UnparsingMode string `json:"unparsing_mode"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(data, &body))
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			require.NoError(t, tt.call(NewMainHelpers(server.URL)))
+			require.Equal(t, tt.want, body.UnparsingMode)
+		})
+	}
+}
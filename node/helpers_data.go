@@ -0,0 +1,221 @@
+package node
+
+import stdJSON "encoding/json"
+
+// RunOperationRequest -
+type RunOperationRequest struct {
+	Operation UnsignedOperation `json:"operation"`
+	ChainID   string            `json:"chain_id"`
+}
+
+// SimulateOperationRequest -
+type SimulateOperationRequest struct {
+	Operation              UnsignedOperation `json:"operation"`
+	ChainID                string            `json:"chain_id"`
+	Latency                *uint64           `json:"latency,omitempty"`
+	BlocksBeforeActivation *uint64           `json:"blocks_before_activation,omitempty"`
+}
+
+// UnsignedOperation - operation group which is sent to simulation. Signature isn't checked by node but must have valid format.
+type UnsignedOperation struct {
+	Branch    string      `json:"branch"`
+	Contents  []Operation `json:"contents"`
+	Signature string      `json:"signature"`
+}
+
+// RunOperationResponse -
+type RunOperationResponse struct {
+	Contents  []RunOperationContent `json:"contents"`
+	Signature string                `json:"signature,omitempty"`
+}
+
+// RunOperationContent - simulated operation with its receipt
+type RunOperationContent struct {
+	Operation
+	Metadata RunOperationMetadata `json:"-"`
+}
+
+// UnmarshalJSON -
+func (content *RunOperationContent) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &content.Operation); err != nil {
+		return err
+	}
+	var buf struct {
+		Metadata RunOperationMetadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &buf); err != nil {
+		return err
+	}
+	content.Metadata = buf.Metadata
+	return nil
+}
+
+// RunOperationMetadata -
+type RunOperationMetadata struct {
+	BalanceUpdates           []BalanceUpdate           `json:"balance_updates"`
+	OperationResult          OperationResult           `json:"operation_result"`
+	InternalOperationResults []InternalOperationResult `json:"internal_operation_results,omitempty"`
+}
+
+// PreapplyOperation - signed operation group which is preapplied
+type PreapplyOperation struct {
+	Protocol  string      `json:"protocol"`
+	Branch    string      `json:"branch"`
+	Contents  []Operation `json:"contents"`
+	Signature string      `json:"signature"`
+}
+
+// unparsing modes
+const (
+	UnparsingModeReadable        = "Readable"
+	UnparsingModeOptimized       = "Optimized"
+	UnparsingModeOptimizedLegacy = "Optimized_legacy"
+)
+
+// RunCodeRequest - request of `run_code` and `trace_code`
+type RunCodeRequest struct {
+	Script         stdJSON.RawMessage `json:"script"`
+	Storage        stdJSON.RawMessage `json:"storage"`
+	Input          stdJSON.RawMessage `json:"input"`
+	Amount         string             `json:"amount"`
+	Balance        string             `json:"balance,omitempty"`
+	ChainID        string             `json:"chain_id"`
+	Source         string             `json:"source,omitempty"`
+	Payer          string             `json:"payer,omitempty"`
+	Self           string             `json:"self,omitempty"`
+	Entrypoint     string             `json:"entrypoint,omitempty"`
+	UnparsingMode  string             `json:"unparsing_mode,omitempty"`
+	Gas            string             `json:"gas,omitempty"`
+	Now            string             `json:"now,omitempty"`
+	Level          string             `json:"level,omitempty"`
+	OtherContracts []OtherContract    `json:"other_contracts,omitempty"`
+	ExtraBigMaps   []ExtraBigMap      `json:"extra_big_maps,omitempty"`
+}
+
+// OtherContract - contract which is visible for script in `run_code`
+type OtherContract struct {
+	Address string             `json:"address"`
+	Type    stdJSON.RawMessage `json:"type"`
+}
+
+// ExtraBigMap - big map which is visible for script in `run_code`
+type ExtraBigMap struct {
+	ID         string             `json:"id"`
+	KeyType    stdJSON.RawMessage `json:"key_type"`
+	ValType    stdJSON.RawMessage `json:"val_type"`
+	MapLiteral stdJSON.RawMessage `json:"map_literal"`
+}
+
+// RunCodeResponse -
+type RunCodeResponse struct {
+	Storage         stdJSON.RawMessage  `json:"storage"`
+	Operations      []InternalOperation `json:"operations"`
+	LazyStorageDiff []LazyStorageDiff   `json:"lazy_storage_diff,omitempty"`
+}
+
+// TraceCodeResponse -
+type TraceCodeResponse struct {
+	RunCodeResponse
+	Trace []TraceItem `json:"trace"`
+}
+
+// TraceItem - state of the stack after instruction
+type TraceItem struct {
+	Location int64                `json:"location"`
+	Gas      string               `json:"gas"`
+	Stack    []stdJSON.RawMessage `json:"stack"`
+}
+
+// RunViewRequest - request of `run_view` which calls TZIP-4 view (entrypoint with callback)
+type RunViewRequest struct {
+	Contract      string             `json:"contract"`
+	Entrypoint    string             `json:"entrypoint"`
+	Input         stdJSON.RawMessage `json:"input"`
+	ChainID       string             `json:"chain_id"`
+	Source        string             `json:"source,omitempty"`
+	Payer         string             `json:"payer,omitempty"`
+	Gas           string             `json:"gas,omitempty"`
+	UnparsingMode string             `json:"unparsing_mode"`
+	Now           string             `json:"now,omitempty"`
+	Level         string             `json:"level,omitempty"`
+}
+
+// RunScriptViewRequest - request of `run_script_view` which calls on-chain view
+type RunScriptViewRequest struct {
+	Contract      string             `json:"contract"`
+	View          string             `json:"view"`
+	Input         stdJSON.RawMessage `json:"input"`
+	UnlimitedGas  bool               `json:"unlimited_gas,omitempty"`
+	ChainID       string             `json:"chain_id"`
+	Source        string             `json:"source,omitempty"`
+	Payer         string             `json:"payer,omitempty"`
+	Gas           string             `json:"gas,omitempty"`
+	UnparsingMode string             `json:"unparsing_mode"`
+	Now           string             `json:"now,omitempty"`
+	Level         string             `json:"level,omitempty"`
+}
+
+// RunViewResponse -
+type RunViewResponse struct {
+	Data stdJSON.RawMessage `json:"data"`
+}
+
+// TypecheckCodeRequest -
+type TypecheckCodeRequest struct {
+	Program   stdJSON.RawMessage `json:"program"`
+	Gas       string             `json:"gas,omitempty"`
+	Legacy    bool               `json:"legacy,omitempty"`
+	ShowTypes *bool              `json:"show_types,omitempty"`
+}
+
+// TypecheckCodeResponse -
+type TypecheckCodeResponse struct {
+	TypeMap []TypeMapItem `json:"type_map"`
+	Gas     string        `json:"gas"`
+}
+
+// TypeMapItem - stack types before and after instruction
+type TypeMapItem struct {
+	Location    int64                `json:"location"`
+	StackBefore []stdJSON.RawMessage `json:"stack_before"`
+	StackAfter  []stdJSON.RawMessage `json:"stack_after"`
+}
+
+// TypecheckDataRequest -
+type TypecheckDataRequest struct {
+	Data   stdJSON.RawMessage `json:"data"`
+	Type   stdJSON.RawMessage `json:"type"`
+	Gas    string             `json:"gas,omitempty"`
+	Legacy bool               `json:"legacy,omitempty"`
+}
+
+// TypecheckDataResponse -
+type TypecheckDataResponse struct {
+	Gas string `json:"gas"`
+}
+
+// PackDataRequest -
+type PackDataRequest struct {
+	Data stdJSON.RawMessage `json:"data"`
+	Type stdJSON.RawMessage `json:"type"`
+	Gas  string             `json:"gas,omitempty"`
+}
+
+// PackDataResponse -
+type PackDataResponse struct {
+	Packed string `json:"packed"`
+	Gas    string `json:"gas"`
+}
+
+// NormalizeDataRequest -
+type NormalizeDataRequest struct {
+	Data          stdJSON.RawMessage `json:"data"`
+	Type          stdJSON.RawMessage `json:"type"`
+	UnparsingMode string             `json:"unparsing_mode"`
+	Legacy        bool               `json:"legacy,omitempty"`
+}
+
+// NormalizeDataResponse -
+type NormalizeDataResponse struct {
+	Normalized stdJSON.RawMessage `json:"normalized"`
+}
@@ -16,10 +16,10 @@ type Inject struct {
 }
 
 // NewInject -
-func NewInject(baseURL string) *Inject {
+func NewInject(baseURL string, opts ...ClientOption) *Inject {
 	return &Inject{
 		baseURL: baseURL,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
@@ -23,8 +23,9 @@ const (
 
 // Monitor -
 type Monitor struct {
-	url    string
-	client *http.Client
+	url          string
+	client       *http.Client
+	streamClient *http.Client
 
 	applied       chan []*Applied
 	refused       chan []*FailedMonitor
@@ -32,34 +33,77 @@ type Monitor struct {
 	branchRefused chan []*FailedMonitor
 	outdated      chan []*FailedMonitor
 
+	heads           chan *MonitoredHead
+	validatedBlocks chan *MonitoredBlock
+	appliedBlocks   chan *MonitoredBlock
+	protocols       chan string
+
 	subscribedOnApplied       bool
 	subscribedOnRefused       bool
 	subscribedOnBranchDelayed bool
 	subscribedOnBranchRefused bool
 	subscribedOnOutdated      bool
 
+	subscribedOnHeads           bool
+	subscribedOnValidatedBlocks bool
+	subscribedOnAppliedBlocks   bool
+	subscribedOnProtocols       bool
+
+	bufferSize       int
+	blocksBufferSize int
+	overflow         OverflowPolicy
+	onReconnect      func(ReconnectEvent)
+	stats            map[string]*streamStats
+
 	wg sync.WaitGroup
 }
 
 // NewMonitor -
-func NewMonitor(url string) *Monitor {
+func NewMonitor(url string, opts ...MonitorOption) *Monitor {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.MaxIdleConns = 100
 	t.MaxConnsPerHost = 100
 	t.MaxIdleConnsPerHost = 100
 
-	return &Monitor{
-		url:           strings.TrimSuffix(url, "/"),
-		applied:       make(chan []*Applied, 4096),
-		refused:       make(chan []*FailedMonitor, 4096),
-		branchDelayed: make(chan []*FailedMonitor, 4096),
-		branchRefused: make(chan []*FailedMonitor, 4096),
-		outdated:      make(chan []*FailedMonitor, 4096),
+	monitor := &Monitor{
+		url:              strings.TrimSuffix(url, "/"),
+		bufferSize:       DefaultMempoolBufferSize,
+		blocksBufferSize: DefaultBlocksBufferSize,
+		overflow:         OverflowBlock,
+		stats:            make(map[string]*streamStats),
+
 		client: &http.Client{
 			Transport: t,
 			Timeout:   time.Minute,
 		},
+		// streams are infinite, so the client has no timeout. They are stopped by context.
+		streamClient: &http.Client{
+			Transport: t,
+		},
 	}
+	for i := range opts {
+		opts[i](monitor)
+	}
+
+	monitor.applied = make(chan []*Applied, monitor.bufferSize)
+	monitor.refused = make(chan []*FailedMonitor, monitor.bufferSize)
+	monitor.branchDelayed = make(chan []*FailedMonitor, monitor.bufferSize)
+	monitor.branchRefused = make(chan []*FailedMonitor, monitor.bufferSize)
+	monitor.outdated = make(chan []*FailedMonitor, monitor.bufferSize)
+
+	monitor.heads = make(chan *MonitoredHead, monitor.blocksBufferSize)
+	monitor.validatedBlocks = make(chan *MonitoredBlock, monitor.blocksBufferSize)
+	monitor.appliedBlocks = make(chan *MonitoredBlock, monitor.blocksBufferSize)
+	monitor.protocols = make(chan string, 16)
+
+	for _, stream := range []string{
+		filterApplied, filterRefused, filterBranchRefused, filterBranchDelayed, filterOutdated,
+		streamHeads, streamValidatedBlocks, streamAppliedBlocks, streamProtocols,
+	} {
+		monitor.stats[stream] = new(streamStats)
+	}
+
+	return monitor
 }
 
 // SubscribeOnMempoolApplied -
@@ -125,6 +169,10 @@ func (monitor *Monitor) Close() error {
 	close(monitor.branchDelayed)
 	close(monitor.branchRefused)
 	close(monitor.outdated)
+	close(monitor.heads)
+	close(monitor.validatedBlocks)
+	close(monitor.appliedBlocks)
+	close(monitor.protocols)
 	return nil
 }
 
@@ -165,16 +213,27 @@ func (monitor *Monitor) pollingMempool(ctx context.Context, filter string) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
+	var (
+		started bool
+		err     error
+	)
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := monitor.process(ctx, filter, url); err != nil {
-				log.Err(err).Msg("")
-				continue
+			// every long polling request after the first one is a reconnection
+			if started {
+				monitor.reconnected(filter, err)
 			}
+			started = true
 
+			if err = monitor.process(ctx, filter, url); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Err(err).Str("filter", filter).Msg("")
+			}
 		}
 	}
 }
@@ -182,38 +241,39 @@ func (monitor *Monitor) pollingMempool(ctx context.Context, filter string) {
 func (monitor *Monitor) process(ctx context.Context, filter, url string) error {
 	switch filter {
 	case filterApplied:
-		return monitor.longPollingApplied(ctx, url, monitor.applied)
+		return monitor.longPollingApplied(ctx, filter, url, monitor.applied)
 	case filterBranchDelayed:
-		return monitor.longPollingFailed(ctx, url, monitor.branchDelayed)
+		return monitor.longPollingFailed(ctx, filter, url, monitor.branchDelayed)
 	case filterBranchRefused:
-		return monitor.longPollingFailed(ctx, url, monitor.branchRefused)
+		return monitor.longPollingFailed(ctx, filter, url, monitor.branchRefused)
 	case filterRefused:
-		return monitor.longPollingFailed(ctx, url, monitor.refused)
+		return monitor.longPollingFailed(ctx, filter, url, monitor.refused)
 	case filterOutdated:
-		return monitor.longPollingFailed(ctx, url, monitor.outdated)
+		return monitor.longPollingFailed(ctx, filter, url, monitor.outdated)
 	default:
 		return errors.Errorf("unknown filter: %s", filter)
 	}
 }
 
-func (monitor *Monitor) longPollingApplied(ctx context.Context, url string, ch chan []*Applied) error {
+func (monitor *Monitor) longPollingApplied(ctx context.Context, filter, url string, ch chan []*Applied) error {
 	link := fmt.Sprintf("%s/%s", monitor.url, url)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
 	if err != nil {
 		return err
 	}
-	client := http.Client{
-		Timeout: time.Minute,
+	resp, err := monitor.client.Do(req) //nolint:gosec
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
 
-	resp, err := client.Do(req) //nolint:gosec
-	if err != nil {
+	if err := checkMonitorResponse(resp); err != nil {
 		return err
 	}
-	return monitor.parseLongPollingAppliedResponse(ctx, resp, ch)
+	return monitor.parseLongPollingAppliedResponse(ctx, filter, resp, ch)
 }
 
-func (monitor *Monitor) parseLongPollingAppliedResponse(ctx context.Context, resp *http.Response, ch chan []*Applied) error {
+func (monitor *Monitor) parseLongPollingAppliedResponse(ctx context.Context, filter string, resp *http.Response, ch chan []*Applied) error {
 	if resp == nil {
 		return errors.New("nil response on mempool long polling request")
 	}
@@ -235,13 +295,15 @@ func (monitor *Monitor) parseLongPollingAppliedResponse(ctx context.Context, res
 				}
 				return err
 			}
-			ch <- value
+			if err := send(ctx, monitor, filter, ch, value); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func (monitor *Monitor) longPollingFailed(ctx context.Context, url string, ch chan []*FailedMonitor) error {
+func (monitor *Monitor) longPollingFailed(ctx context.Context, filter, url string, ch chan []*FailedMonitor) error {
 	link := fmt.Sprintf("%s/%s", monitor.url, url)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
 	if err != nil {
@@ -252,10 +314,15 @@ func (monitor *Monitor) longPollingFailed(ctx context.Context, url string, ch ch
 	if err != nil {
 		return err
 	}
-	return monitor.parseLongPollingFailedResponse(ctx, resp, ch)
+	defer resp.Body.Close()
+
+	if err := checkMonitorResponse(resp); err != nil {
+		return err
+	}
+	return monitor.parseLongPollingFailedResponse(ctx, filter, resp, ch)
 }
 
-func (monitor *Monitor) parseLongPollingFailedResponse(ctx context.Context, resp *http.Response, ch chan []*FailedMonitor) error {
+func (monitor *Monitor) parseLongPollingFailedResponse(ctx context.Context, filter string, resp *http.Response, ch chan []*FailedMonitor) error {
 	if resp == nil {
 		return errors.New("nil response on mempool long polling request")
 	}
@@ -277,8 +344,28 @@ func (monitor *Monitor) parseLongPollingFailedResponse(ctx context.Context, resp
 				}
 				return err
 			}
-			ch <- value
+			if err := send(ctx, monitor, filter, ch, value); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
+
+func checkMonitorResponse(resp *http.Response) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return RequestError{
+			Code: resp.StatusCode,
+			Body: resp.Status,
+			Err:  err,
+		}
+	}
+	return RequestError{
+		Code: resp.StatusCode,
+		Body: string(data),
+	}
+}
@@ -0,0 +1,16 @@
+package node
+
+// MonitoredHead - item of `/monitor/heads/<chain_id>` stream
+type MonitoredHead struct {
+	Hash string `json:"hash"`
+	HeaderShell
+	ProtocolData string `json:"protocol_data"`
+}
+
+// MonitoredBlock - item of `/monitor/validated_blocks` and `/monitor/applied_blocks` streams
+type MonitoredBlock struct {
+	ChainID    string             `json:"chain_id"`
+	Hash       string             `json:"hash"`
+	Header     Header             `json:"header"`
+	Operations [][]OperationGroup `json:"operations"`
+}
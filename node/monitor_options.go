@@ -0,0 +1,192 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// default monitor parameters
+const (
+	DefaultMempoolBufferSize = 4096
+	DefaultBlocksBufferSize  = 1024
+)
+
+// OverflowPolicy - behaviour of monitor when consumer doesn't read a channel and its buffer is full
+type OverflowPolicy int
+
+// overflow policies
+const (
+	// OverflowBlock - monitor waits until consumer reads the channel. Node may close the stream meanwhile.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest - the oldest message in the buffer is dropped to free space for the new one
+	OverflowDropOldest
+)
+
+// ReconnectEvent - is passed to reconnect handler when a stream is reopened.
+// Messages sent by the node between disconnection and reconnection are lost.
+type ReconnectEvent struct {
+	// Stream - name of stream: mempool filter (`applied`, `refused`, `branch_refused`, `branch_delayed`, `outdated`)
+	// or `heads`, `validated_blocks`, `applied_blocks`, `protocols`
+	Stream string
+	// Err - reason of disconnection. It's nil if the node closed the stream itself.
+	Err  error
+	Time time.Time
+}
+
+// MonitorStats - statistics of a monitor stream
+type MonitorStats struct {
+	// Received - count of messages received from the node including dropped ones
+	Received uint64
+	// Dropped - count of messages dropped because of full channel buffer
+	Dropped     uint64
+	Reconnects  uint64
+	Errors      uint64
+	LastError   error
+	LastMessage time.Time
+}
+
+// MonitorOption -
+type MonitorOption func(*Monitor)
+
+// WithBufferSize - sets size of mempool channels buffers. Default: 4096.
+func WithBufferSize(size int) MonitorOption {
+	return func(m *Monitor) {
+		if size >= 0 {
+			m.bufferSize = size
+		}
+	}
+}
+
+// WithBlocksBufferSize - sets size of heads and blocks channels buffers. Default: 1024.
+func WithBlocksBufferSize(size int) MonitorOption {
+	return func(m *Monitor) {
+		if size >= 0 {
+			m.blocksBufferSize = size
+		}
+	}
+}
+
+// WithOverflowPolicy - sets behaviour on full channel buffer. Default: OverflowBlock.
+func WithOverflowPolicy(policy OverflowPolicy) MonitorOption {
+	return func(m *Monitor) {
+		m.overflow = policy
+	}
+}
+
+// WithReconnectHandler - sets function which is called on every reconnection of a stream.
+// It's called from the stream goroutine, so it should not block.
+func WithReconnectHandler(handler func(ReconnectEvent)) MonitorOption {
+	return func(m *Monitor) {
+		m.onReconnect = handler
+	}
+}
+
+// WithMonitorTransport - replaces transport of request and stream clients. Nil is ignored.
+func WithMonitorTransport(transport http.RoundTripper) MonitorOption {
+	return func(m *Monitor) {
+		if transport != nil {
+			m.client.Transport = transport
+			m.streamClient.Transport = transport
+		}
+	}
+}
+
+type streamStats struct {
+	mx    sync.Mutex
+	stats MonitorStats
+}
+
+func (s *streamStats) received() {
+	s.mx.Lock()
+	s.stats.Received++
+	s.stats.LastMessage = time.Now()
+	s.mx.Unlock()
+}
+
+func (s *streamStats) dropped() {
+	s.mx.Lock()
+	s.stats.Dropped++
+	s.mx.Unlock()
+}
+
+func (s *streamStats) reconnected(err error) {
+	s.mx.Lock()
+	s.stats.Reconnects++
+	if err != nil {
+		s.stats.Errors++
+		s.stats.LastError = err
+	}
+	s.mx.Unlock()
+}
+
+func (s *streamStats) get() MonitorStats {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return s.stats
+}
+
+// Stats - returns statistics of the stream. Names are the same as in `ReconnectEvent`.
+func (monitor *Monitor) Stats(stream string) (MonitorStats, bool) {
+	stats, ok := monitor.stats[stream]
+	if !ok {
+		return MonitorStats{}, false
+	}
+	return stats.get(), true
+}
+
+// AllStats - returns statistics of all streams
+func (monitor *Monitor) AllStats() map[string]MonitorStats {
+	result := make(map[string]MonitorStats, len(monitor.stats))
+	for name, stats := range monitor.stats {
+		result[name] = stats.get()
+	}
+	return result
+}
+
+func (monitor *Monitor) reconnected(stream string, err error) {
+	monitor.stats[stream].reconnected(err)
+	if monitor.onReconnect != nil {
+		monitor.onReconnect(ReconnectEvent{
+			Stream: stream,
+			Err:    err,
+			Time:   time.Now(),
+		})
+	}
+}
+
+// send - pushes value to channel according to overflow policy
+func send[T any](ctx context.Context, monitor *Monitor, stream string, ch chan T, value T) error {
+	stats := monitor.stats[stream]
+	stats.received()
+
+	if monitor.overflow == OverflowDropOldest {
+		for {
+			select {
+			case ch <- value:
+				return nil
+			default:
+			}
+
+			if cap(ch) == 0 {
+				// there is no buffer to free, so the new message is dropped
+				stats.dropped()
+				return nil
+			}
+
+			select {
+			case <-ch:
+				stats.dropped()
+			default:
+			}
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case ch <- value:
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func waitStats(t *testing.T, monitor *Monitor, stream string, condition func(MonitorStats) bool) MonitorStats {
+	t.Helper()
+	var stats MonitorStats
+	require.Eventually(t, func() bool {
+		stats, _ = monitor.Stats(stream)
+		return condition(stats)
+	}, 5*time.Second, 10*time.Millisecond)
+	return stats
+}
+
+func TestMonitor_Overflow(t *testing.T) {
+	const count = 5
+	chunks := make([]string, count)
+	for i := range chunks {
+		chunks[i] = fmt.Sprintf(`{"hash":"head%d"}`, i)
+	}
+
+	tests := []struct {
+		name       string
+		policy     OverflowPolicy
+		bufferSize int
+		// received - count of messages which are received from the node before the consumer starts reading
+		received uint64
+		dropped  uint64
+		want     []string
+	}{
+		{
+			name:       "block",
+			policy:     OverflowBlock,
+			bufferSize: 2,
+			received:   3,
+			want:       []string{"head0", "head1", "head2", "head3", "head4"},
+		}, {
+			name:       "drop oldest",
+			policy:     OverflowDropOldest,
+			bufferSize: 2,
+			received:   count,
+			dropped:    3,
+			want:       []string{"head3", "head4"},
+		}, {
+			name:       "drop oldest without buffer",
+			policy:     OverflowDropOldest,
+			bufferSize: 0,
+			received:   count,
+			dropped:    count,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStreamServer(t, map[string][]string{"/monitor/heads/main": chunks}, true)
+
+			ctx, cancel := context.WithCancel(t.Context())
+			monitor := NewMonitor(server.URL, WithBlocksBufferSize(tt.bufferSize), WithOverflowPolicy(tt.policy))
+			defer func() {
+				cancel()
+				require.NoError(t, monitor.Close())
+			}()
+
+			monitor.SubscribeOnHeads(ctx, "")
+			stats := waitStats(t, monitor, streamHeads, func(stats MonitorStats) bool {
+				return stats.Received == tt.received && stats.Dropped == tt.dropped
+			})
+			require.False(t, stats.LastMessage.IsZero())
+
+			for i := range tt.want {
+				require.Equal(t, tt.want[i], receive(t, monitor.Heads()).Hash)
+			}
+
+			stats = waitStats(t, monitor, streamHeads, func(stats MonitorStats) bool {
+				return stats.Received == count
+			})
+			require.Equal(t, tt.dropped, stats.Dropped)
+			require.Empty(t, monitor.Heads())
+		})
+	}
+}
+
+func TestMonitor_Reconnect(t *testing.T) {
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg"`))
+		w.(http.Flusher).Flush()
+
+		if connections.Add(1) > 1 {
+			// the stream is closed by the node
+			return
+		}
+
+		// the connection is dropped in the middle of a message
+		_, _ = w.Write([]byte(`"PtParisBxoLz5gzMmn3d9WBQNo`))
+		w.(http.Flusher).Flush()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	}))
+	defer server.Close()
+
+	events := make(chan ReconnectEvent, 16)
+	ctx, cancel := context.WithCancel(t.Context())
+	monitor := NewMonitor(server.URL, WithReconnectHandler(func(event ReconnectEvent) {
+		select {
+		case events <- event:
+		default:
+		}
+	}))
+	defer func() {
+		cancel()
+		require.NoError(t, monitor.Close())
+	}()
+
+	start := time.Now()
+	monitor.SubscribeOnProtocols(ctx)
+
+	event := receive(t, events)
+	require.Equal(t, streamProtocols, event.Stream)
+	require.Error(t, event.Err)
+	require.False(t, event.Time.Before(start))
+
+	event = receive(t, events)
+	require.Equal(t, streamProtocols, event.Stream)
+	require.NoError(t, event.Err)
+
+	stats := waitStats(t, monitor, streamProtocols, func(stats MonitorStats) bool {
+		return stats.Received >= 2
+	})
+	require.GreaterOrEqual(t, stats.Reconnects, uint64(2))
+	require.EqualValues(t, 1, stats.Errors)
+	require.Error(t, stats.LastError)
+	require.Zero(t, stats.Dropped)
+
+	all := monitor.AllStats()
+	require.Len(t, all, 9)
+	require.Equal(t, uint64(0), all[streamHeads].Received)
+	require.Equal(t, uint64(1), all[streamProtocols].Errors)
+
+	_, ok := monitor.Stats("unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,146 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// names of streams
+const (
+	streamHeads           = "heads"
+	streamValidatedBlocks = "validated_blocks"
+	streamAppliedBlocks   = "applied_blocks"
+	streamProtocols       = "protocols"
+)
+
+// SubscribeOnHeads - streams heads of the chain `chainID`. If `chainID` is empty `main` is used.
+func (monitor *Monitor) SubscribeOnHeads(ctx context.Context, chainID string) {
+	if monitor.subscribedOnHeads {
+		return
+	}
+	monitor.subscribedOnHeads = true
+
+	if chainID == "" {
+		chainID = "main"
+	}
+
+	monitor.wg.Add(1)
+	go streaming(ctx, monitor, streamHeads, fmt.Sprintf("monitor/heads/%s", chainID), monitor.heads)
+}
+
+// SubscribeOnValidatedBlocks - streams blocks which were validated by the node
+func (monitor *Monitor) SubscribeOnValidatedBlocks(ctx context.Context) {
+	if monitor.subscribedOnValidatedBlocks {
+		return
+	}
+	monitor.subscribedOnValidatedBlocks = true
+
+	monitor.wg.Add(1)
+	go streaming(ctx, monitor, streamValidatedBlocks, "monitor/validated_blocks", monitor.validatedBlocks)
+}
+
+// SubscribeOnAppliedBlocks - streams blocks which were applied by the node
+func (monitor *Monitor) SubscribeOnAppliedBlocks(ctx context.Context) {
+	if monitor.subscribedOnAppliedBlocks {
+		return
+	}
+	monitor.subscribedOnAppliedBlocks = true
+
+	monitor.wg.Add(1)
+	go streaming(ctx, monitor, streamAppliedBlocks, "monitor/applied_blocks", monitor.appliedBlocks)
+}
+
+// SubscribeOnProtocols - streams hashes of protocols which were activated by the node
+func (monitor *Monitor) SubscribeOnProtocols(ctx context.Context) {
+	if monitor.subscribedOnProtocols {
+		return
+	}
+	monitor.subscribedOnProtocols = true
+
+	monitor.wg.Add(1)
+	go streaming(ctx, monitor, streamProtocols, "monitor/protocols", monitor.protocols)
+}
+
+// Heads -
+func (monitor *Monitor) Heads() <-chan *MonitoredHead {
+	return monitor.heads
+}
+
+// ValidatedBlocks -
+func (monitor *Monitor) ValidatedBlocks() <-chan *MonitoredBlock {
+	return monitor.validatedBlocks
+}
+
+// AppliedBlocks -
+func (monitor *Monitor) AppliedBlocks() <-chan *MonitoredBlock {
+	return monitor.appliedBlocks
+}
+
+// Protocols -
+func (monitor *Monitor) Protocols() <-chan string {
+	return monitor.protocols
+}
+
+// streaming - reads the stream `uri` and reconnects when it fails or is closed by the node
+func streaming[T any](ctx context.Context, monitor *Monitor, name, uri string, ch chan T) {
+	defer monitor.wg.Done()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		err := stream(ctx, monitor, name, uri, ch)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Err(err).Str("stream", uri).Msg("reconnecting")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			monitor.reconnected(name, err)
+		}
+	}
+}
+
+func stream[T any](ctx context.Context, monitor *Monitor, name, uri string, ch chan T) error {
+	link := fmt.Sprintf("%s/%s", monitor.url, uri)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := monitor.streamClient.Do(req) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkMonitorResponse(resp); err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if err := send(ctx, monitor, name, ch, value); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// streamServer - fake node which writes chunks of streams and closes them or holds them open until request is canceled
+type streamServer struct {
+	*httptest.Server
+
+	mx          sync.Mutex
+	connections map[string][]time.Time
+}
+
+func newStreamServer(t *testing.T, chunks map[string][]string, hold bool) *streamServer {
+	server := &streamServer{
+		connections: make(map[string][]time.Time),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mx.Lock()
+		server.connections[r.URL.Path] = append(server.connections[r.URL.Path], time.Now())
+		server.mx.Unlock()
+
+		data, ok := chunks[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for i := range data {
+			_, _ = w.Write([]byte(data[i]))
+			w.(http.Flusher).Flush()
+		}
+		if hold {
+			<-r.Context().Done()
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *streamServer) connectionTimes(path string) []time.Time {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return append([]time.Time(nil), s.connections[path]...)
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case value, ok := <-ch:
+		require.True(t, ok, "channel is closed")
+		return value
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message wasn't received")
+	}
+	var value T
+	return value
+}
+
+func requireClosed[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-time.After(time.Second):
+			require.FailNow(t, "channel isn't closed")
+		}
+	}
+}
+
+func TestMonitor_Streams(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		chunks    []string
+		subscribe func(ctx context.Context, monitor *Monitor)
+		check     func(t *testing.T, monitor *Monitor)
+	}{
+		{
+			name: "heads",
+			path: "/monitor/heads/main",
+			// the first object is split between chunks
+			chunks: []string{
+				`{"hash":"BLockHash1","level":100,"proto":2,"predecessor":"BLockHash0","timestamp":"2024-01-01T00:00:00Z",`,
+				`"validation_pass":4,"fitness":["02","00000064"],"protocol_data":"0000"}` + "\n",
+				`{"hash":"BLockHash2","level":101}` + "\n",
+			},
+			subscribe: func(ctx context.Context, monitor *Monitor) {
+				monitor.SubscribeOnHeads(ctx, "")
+			},
+			check: func(t *testing.T, monitor *Monitor) {
+				head := receive(t, monitor.Heads())
+				require.Equal(t, "BLockHash1", head.Hash)
+				require.EqualValues(t, 100, head.Level)
+				require.Equal(t, 2, head.Proto)
+				require.Equal(t, "BLockHash0", head.Predecessor)
+				require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), head.Timestamp.UTC())
+				require.Equal(t, []string{"02", "00000064"}, head.Fitness)
+				require.Equal(t, "0000", head.ProtocolData)
+
+				head = receive(t, monitor.Heads())
+				require.Equal(t, "BLockHash2", head.Hash)
+				require.EqualValues(t, 101, head.Level)
+			},
+		}, {
+			name:   "validated blocks",
+			path:   "/monitor/validated_blocks",
+			chunks: []string{`{"chain_id":"NetXdQprcVkpaWU","hash":"BLockHash1","header":{"level":100,"proto":2},"operations":[[],[{"hash":"opHash","branch":"BLockHash0","contents":[{"kind":"transaction","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","amount":"10"}]}]]}`},
+			subscribe: func(ctx context.Context, monitor *Monitor) {
+				monitor.SubscribeOnValidatedBlocks(ctx)
+			},
+			check: func(t *testing.T, monitor *Monitor) {
+				block := receive(t, monitor.ValidatedBlocks())
+				require.Equal(t, "NetXdQprcVkpaWU", block.ChainID)
+				require.Equal(t, "BLockHash1", block.Hash)
+				require.EqualValues(t, 100, block.Header.Level)
+				require.Len(t, block.Operations, 2)
+				require.Empty(t, block.Operations[0])
+				require.Len(t, block.Operations[1], 1)
+				require.Equal(t, "opHash", block.Operations[1][0].Hash)
+
+				transaction, err := NewTypedOperation[Transaction](block.Operations[1][0].Contents[0])
+				require.NoError(t, err)
+				require.Equal(t, "10", transaction.Amount)
+			},
+		}, {
+			name:   "applied blocks",
+			path:   "/monitor/applied_blocks",
+			chunks: []string{`{"chain_id":"NetXdQprcVkpaWU","hash":"BLockHash1","header":{"level":100},"operations":[]}{"chain_id":"NetXdQprcVkpaWU","hash":"BLockHash2","header":{"level":101},"operations":[]}`},
+			subscribe: func(ctx context.Context, monitor *Monitor) {
+				monitor.SubscribeOnAppliedBlocks(ctx)
+			},
+			check: func(t *testing.T, monitor *Monitor) {
+				require.Equal(t, "BLockHash1", receive(t, monitor.AppliedBlocks()).Hash)
+				require.Equal(t, "BLockHash2", receive(t, monitor.AppliedBlocks()).Hash)
+			},
+		}, {
+			name:   "protocols",
+			path:   "/monitor/protocols",
+			chunks: []string{`"PtParisBxoLz5gzMmn3d9WBQNoPSZakgnkMC2VNuQ3KXfUtUQeZ"` + "\n", `"PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg"`},
+			subscribe: func(ctx context.Context, monitor *Monitor) {
+				monitor.SubscribeOnProtocols(ctx)
+			},
+			check: func(t *testing.T, monitor *Monitor) {
+				require.Equal(t, "PtParisBxoLz5gzMmn3d9WBQNoPSZakgnkMC2VNuQ3KXfUtUQeZ", receive(t, monitor.Protocols()))
+				require.Equal(t, "PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg", receive(t, monitor.Protocols()))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStreamServer(t, map[string][]string{tt.path: tt.chunks}, true)
+
+			ctx, cancel := context.WithCancel(t.Context())
+			monitor := NewMonitor(server.URL)
+			defer func() {
+				cancel()
+				require.NoError(t, monitor.Close())
+			}()
+
+			tt.subscribe(ctx, monitor)
+			tt.check(t, monitor)
+		})
+	}
+}
+
+func TestMonitor_ReconnectDelay(t *testing.T) {
+	const path = "/monitor/heads/main"
+	server := newStreamServer(t, map[string][]string{
+		path: {`{"hash":"BLockHash1","level":100}`},
+	}, false)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	monitor := NewMonitor(server.URL)
+	defer func() {
+		cancel()
+		require.NoError(t, monitor.Close())
+	}()
+
+	monitor.SubscribeOnHeads(ctx, "main")
+	receive(t, monitor.Heads())
+	// the node closed the stream, so it's reopened and the head is received again
+	receive(t, monitor.Heads())
+
+	connections := server.connectionTimes(path)
+	require.GreaterOrEqual(t, len(connections), 2)
+	delay := connections[1].Sub(connections[0])
+	require.GreaterOrEqual(t, delay, 900*time.Millisecond)
+	require.Less(t, delay, 2*time.Second)
+
+	stats, ok := monitor.Stats(streamHeads)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, stats.Reconnects, uint64(1))
+	require.Zero(t, stats.Errors)
+}
+
+func TestMonitor_Cancel(t *testing.T) {
+	server := newStreamServer(t, map[string][]string{
+		"/monitor/heads/main":       {`{"hash":"BLockHash1","level":100}`},
+		"/monitor/validated_blocks": {`{"hash":"BLockHash1"}`},
+		"/monitor/applied_blocks":   {`{"hash":"BLockHash1"}`},
+		"/monitor/protocols":        {`"PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg"`},
+	}, true)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	monitor := NewMonitor(server.URL)
+	monitor.SubscribeOnHeads(ctx, "")
+	monitor.SubscribeOnValidatedBlocks(ctx)
+	monitor.SubscribeOnAppliedBlocks(ctx)
+	monitor.SubscribeOnProtocols(ctx)
+
+	receive(t, monitor.Heads())
+	receive(t, monitor.ValidatedBlocks())
+	receive(t, monitor.AppliedBlocks())
+	receive(t, monitor.Protocols())
+
+	// streams are held open by the node, so only cancellation stops them
+	cancel()
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_ = monitor.Close()
+	}()
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		require.FailNow(t, "streams aren't stopped by context")
+	}
+
+	requireClosed(t, monitor.Heads())
+	requireClosed(t, monitor.ValidatedBlocks())
+	requireClosed(t, monitor.AppliedBlocks())
+	requireClosed(t, monitor.Protocols())
+	requireClosed(t, monitor.Applied())
+}
@@ -20,10 +20,10 @@ type Network struct {
 }
 
 // NewNetwork -
-func NewNetwork(baseURL string) *Network {
+func NewNetwork(baseURL string, opts ...ClientOption) *Network {
 	return &Network{
 		baseURL: baseURL,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
@@ -0,0 +1,194 @@
+package node
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestInfo - description of HTTP request to node which is passed to `Observer`
+type RequestInfo struct {
+	// Node - host of the node
+	Node   string
+	Method string
+	// Path - RPC path relative to base URL, e.g. `chains/main/blocks/head/header`
+	Path string
+	// Endpoint - RPC path with parameters replaced by placeholders, e.g. `chains/{chain_id}/blocks/{block_id}/header`.
+	// It has low cardinality, so it can be used as metric label.
+	Endpoint string
+	// BlockID - block identifier from path. It's empty if RPC is not related to a block.
+	BlockID string
+	// Attempt - number of attempt starting from 1. It's greater than 1 for retried requests.
+	Attempt     int
+	RequestSize int64
+
+	// fields below are filled when request is finished
+
+	StatusCode   int
+	ResponseSize int64
+	Duration     time.Duration
+	// Err - transport error. Responses with error status codes have nil error.
+	Err error
+}
+
+// Observer - receives events of HTTP requests to node. Every attempt of retried request is reported separately.
+type Observer interface {
+	// OnRequest - is called before sending built request. Returned context is used for the request and passed to `OnResponse`.
+	OnRequest(ctx context.Context, info RequestInfo) context.Context
+	// OnResponse - is called when response body is closed or request failed
+	OnResponse(ctx context.Context, info RequestInfo)
+}
+
+// WithObserver - adds observers of requests, e.g. `MetricsObserver` or `TracingObserver`
+func WithObserver(observers ...Observer) ClientOption {
+	return func(c *client) {
+		for i := range observers {
+			if observers[i] != nil {
+				c.observers = append(c.observers, observers[i])
+			}
+		}
+	}
+}
+
+// observation - single attempt of request reported to observers
+type observation struct {
+	ctx       context.Context
+	observers []Observer
+	info      RequestInfo
+	start     time.Time
+	once      sync.Once
+}
+
+func (c *client) observe(ctx context.Context, info RequestInfo) *observation {
+	o := &observation{
+		ctx:       ctx,
+		observers: c.observers,
+		info:      info,
+		start:     time.Now(),
+	}
+	for i := range o.observers {
+		o.ctx = o.observers[i].OnRequest(o.ctx, o.info)
+	}
+	return o
+}
+
+// finish - reports failed request immediately. Successful response is reported when its body is closed,
+// so duration includes body downloading.
+func (o *observation) finish(resp *http.Response, err error) (*http.Response, error) {
+	if len(o.observers) == 0 {
+		return resp, err
+	}
+	if err != nil || resp == nil {
+		o.report(0, 0, err)
+		return resp, err
+	}
+
+	resp.Body = &observedBody{
+		ReadCloser: resp.Body,
+		onClose: func(size int64) {
+			o.report(resp.StatusCode, size, nil)
+		},
+	}
+	return resp, nil
+}
+
+func (o *observation) report(status int, size int64, err error) {
+	o.once.Do(func() {
+		o.info.StatusCode = status
+		o.info.ResponseSize = size
+		o.info.Duration = time.Since(o.start)
+		o.info.Err = err
+		for i := range o.observers {
+			o.observers[i].OnResponse(o.ctx, o.info)
+		}
+	})
+}
+
+type observedBody struct {
+	io.ReadCloser
+
+	size    int64
+	onClose func(size int64)
+}
+
+// Read -
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+// Close -
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.onClose(b.size)
+	return err
+}
+
+// placeholders of path parameters. Segment following the key is replaced by the value.
+var endpointParams = map[string]string{
+	"chains":                     "{chain_id}",
+	"blocks":                     "{block_id}",
+	"big_maps":                   "{big_map_id}",
+	"smart_rollup":               "{smart_rollup}",
+	"staker":                     "{staker}",
+	"commitment":                 "{commitment}",
+	"tx_rollup":                  "{tx_rollup}",
+	"inbox":                      "{inbox}",
+	"pending_bonded_commitments": "{pkh}",
+	"invalid_blocks":             "{block_hash}",
+	"connections":                "{peer_id}",
+	"protocols":                  "{protocol}",
+	"heads":                      "{chain_id}",
+}
+
+// context placeholders which are parameters only under `context`, e.g. not in `context/cache/contracts/size`
+var contextParams = map[string]string{
+	"contracts": "{contract}",
+	"delegates": "{delegate}",
+}
+
+// parseEndpoint - returns RPC path with parameters replaced by placeholders and block identifier
+func parseEndpoint(uri string) (endpoint string, blockID string) {
+	if idx := strings.IndexByte(uri, '?'); idx >= 0 {
+		uri = uri[:idx]
+	}
+	segments := strings.Split(strings.Trim(uri, "/"), "/")
+	for i := 1; i < len(segments); i++ {
+		prev := segments[i-1]
+		if placeholder, ok := endpointParams[prev]; ok {
+			if prev == "blocks" {
+				blockID = segments[i]
+			}
+			segments[i] = placeholder
+			continue
+		}
+		if placeholder, ok := contextParams[prev]; ok && i > 1 && segments[i-2] == "context" {
+			segments[i] = placeholder
+			continue
+		}
+		if i > 1 && segments[i-2] == "big_maps" {
+			segments[i] = "{key_hash}"
+			continue
+		}
+		if isNumber(segments[i]) {
+			segments[i] = "{index}"
+		}
+	}
+	return strings.Join(segments, "/"), blockID
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range s {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
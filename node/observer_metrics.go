@@ -0,0 +1,76 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// names of metrics registered by `MetricsObserver`
+const (
+	MetricRequestDuration = "node_rpc_request_duration_seconds"
+	MetricResponseSize    = "node_rpc_response_size_bytes"
+	MetricRequests        = "node_rpc_requests_total"
+	MetricRetries         = "node_rpc_retries_total"
+)
+
+// MetricsService - registry of metrics used by `MetricsObserver`. It's implemented by `prometheus.Service` of go-lib.
+type MetricsService interface {
+	RegisterCounter(name, help string, labels ...string)
+	RegisterHistogram(name, help string, buckets []float64, labels ...string)
+	IncrementCounter(name string, labels map[string]string)
+	AddHistogramValue(name string, labels map[string]string, observe float64)
+}
+
+// response size buckets from 256B to 64MB
+var responseSizeBuckets = []float64{256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20, 64 << 20}
+
+// MetricsObserver - reports duration, response size, count and retries of requests per node and endpoint.
+// `status` label contains HTTP status code or `error` for transport errors.
+type MetricsObserver struct {
+	service MetricsService
+}
+
+var _ Observer = (*MetricsObserver)(nil)
+
+// NewMetricsObserver - registers metrics in `service`. It should be called once per service because metrics can't be registered twice.
+func NewMetricsObserver(service MetricsService) *MetricsObserver {
+	service.RegisterHistogram(MetricRequestDuration, "Duration of node RPC requests including response downloading", nil, "node", "endpoint", "method", "status")
+	service.RegisterHistogram(MetricResponseSize, "Size of node RPC responses", responseSizeBuckets, "node", "endpoint", "method")
+	service.RegisterCounter(MetricRequests, "Count of node RPC requests", "node", "endpoint", "method", "status")
+	service.RegisterCounter(MetricRetries, "Count of retried node RPC requests", "node", "endpoint", "method")
+	return &MetricsObserver{service: service}
+}
+
+// OnRequest -
+func (m *MetricsObserver) OnRequest(ctx context.Context, info RequestInfo) context.Context {
+	return ctx
+}
+
+// OnResponse -
+func (m *MetricsObserver) OnResponse(ctx context.Context, info RequestInfo) {
+	method := info.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	status := "error"
+	if info.Err == nil {
+		status = strconv.Itoa(info.StatusCode)
+	}
+
+	labels := map[string]string{
+		"node":     info.Node,
+		"endpoint": info.Endpoint,
+		"method":   method,
+	}
+	if info.Attempt > 1 {
+		m.service.IncrementCounter(MetricRetries, labels)
+	}
+	if info.Err == nil {
+		m.service.AddHistogramValue(MetricResponseSize, labels, float64(info.ResponseSize))
+	}
+
+	labels["status"] = status
+	m.service.IncrementCounter(MetricRequests, labels)
+	m.service.AddHistogramValue(MetricRequestDuration, labels, info.Duration.Seconds())
+}
@@ -0,0 +1,324 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		wantEndpoint string
+		wantBlockID  string
+	}{
+		{
+			name:         "block hash",
+			uri:          "chains/main/blocks/BLockGenesisGenesisGenesisGenesisGenesisf79b5d1CoW2/header",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/header",
+			wantBlockID:  "BLockGenesisGenesisGenesisGenesisGenesisf79b5d1CoW2",
+		}, {
+			name:         "level",
+			uri:          "chains/main/blocks/5000000/operations/3/12",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/operations/{index}/{index}",
+			wantBlockID:  "5000000",
+		}, {
+			name:         "head with offset",
+			uri:          "chains/NetXdQprcVkpaWU/blocks/head~10/protocols",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/protocols",
+			wantBlockID:  "head~10",
+		}, {
+			name:         "contract",
+			uri:          "chains/main/blocks/head/context/contracts/KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn/storage?unparsing_mode=Readable",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/context/contracts/{contract}/storage",
+			wantBlockID:  "head",
+		}, {
+			name:         "delegate",
+			uri:          "chains/main/blocks/head/context/delegates/tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk/consensus_key",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/context/delegates/{delegate}/consensus_key",
+			wantBlockID:  "head",
+		}, {
+			name:         "big map",
+			uri:          "chains/main/blocks/head/context/big_maps/5123",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/context/big_maps/{big_map_id}",
+			wantBlockID:  "head",
+		}, {
+			name:         "big map key",
+			uri:          "chains/main/blocks/head/context/big_maps/5123/exprtZBwZUeYYYfUs9B9Rg2ywHezVHnCCnmF9WsDQVrs582dSK63dC",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/context/big_maps/{big_map_id}/{key_hash}",
+			wantBlockID:  "head",
+		}, {
+			name:         "contracts cache is not a contract",
+			uri:          "chains/main/blocks/head/context/cache/contracts/size",
+			wantEndpoint: "chains/{chain_id}/blocks/{block_id}/context/cache/contracts/size",
+			wantBlockID:  "head",
+		}, {
+			name:         "monitor heads",
+			uri:          "monitor/heads/main",
+			wantEndpoint: "monitor/heads/{chain_id}",
+		}, {
+			name:         "injection",
+			uri:          "injection/operation",
+			wantEndpoint: "injection/operation",
+		}, {
+			name:         "blocks list",
+			uri:          "chains/main/blocks",
+			wantEndpoint: "chains/{chain_id}/blocks",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, blockID := parseEndpoint(tt.uri)
+			require.Equal(t, tt.wantEndpoint, endpoint)
+			require.Equal(t, tt.wantBlockID, blockID)
+		})
+	}
+}
+
+// testMetrics - metrics service which records labels of every observation
+type testMetrics struct {
+	mx     sync.Mutex
+	values map[string][]map[string]string
+}
+
+func newTestMetrics() *testMetrics {
+	return &testMetrics{values: make(map[string][]map[string]string)}
+}
+
+func (m *testMetrics) RegisterCounter(name, help string, labels ...string) {}
+
+func (m *testMetrics) RegisterHistogram(name, help string, buckets []float64, labels ...string) {}
+
+func (m *testMetrics) IncrementCounter(name string, labels map[string]string) {
+	m.add(name, labels)
+}
+
+func (m *testMetrics) AddHistogramValue(name string, labels map[string]string, observe float64) {
+	m.add(name, labels)
+}
+
+func (m *testMetrics) add(name string, labels map[string]string) {
+	copied := make(map[string]string, len(labels))
+	for key, value := range labels {
+		copied[key] = value
+	}
+	m.mx.Lock()
+	m.values[name] = append(m.values[name], copied)
+	m.mx.Unlock()
+}
+
+func (m *testMetrics) get(name string) []map[string]string {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return m.values[name]
+}
+
+// testTracer - tracer which records attributes and statuses of spans
+type testTracer struct {
+	noop.Tracer
+
+	mx    sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &testSpan{
+		name:  name,
+		attrs: make(map[attribute.Key]attribute.Value),
+	}
+	config := trace.NewSpanStartConfig(opts...)
+	span.SetAttributes(config.Attributes()...)
+
+	t.mx.Lock()
+	t.spans = append(t.spans, span)
+	t.mx.Unlock()
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type testSpan struct {
+	noop.Span
+
+	name   string
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for i := range kv {
+		s.attrs[kv[i].Key] = kv[i].Value
+	}
+}
+
+func (s *testSpan) SetStatus(code codes.Code, description string) {
+	s.status = code
+}
+
+func (s *testSpan) End(options ...trace.SpanEndOption) {
+	s.ended = true
+}
+
+// requestObserver - records request info passed to `OnRequest`
+type requestObserver struct {
+	mx    sync.Mutex
+	infos []RequestInfo
+}
+
+func (o *requestObserver) OnRequest(ctx context.Context, info RequestInfo) context.Context {
+	o.mx.Lock()
+	o.infos = append(o.infos, info)
+	o.mx.Unlock()
+	return ctx
+}
+
+func (o *requestObserver) OnResponse(ctx context.Context, info RequestInfo) {}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestObservers(t *testing.T) {
+	const endpoint = "chains/{chain_id}/blocks/{block_id}/protocols"
+
+	tests := []struct {
+		name         string
+		statuses     []int
+		retries      int
+		wantStatuses []string
+		wantSpans    []codes.Code
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			statuses:     []int{http.StatusOK},
+			retries:      1,
+			wantStatuses: []string{"200"},
+			wantSpans:    []codes.Code{codes.Unset},
+		}, {
+			name:         "server error",
+			statuses:     []int{http.StatusInternalServerError},
+			retries:      1,
+			wantStatuses: []string{"500"},
+			wantSpans:    []codes.Code{codes.Error},
+			wantErr:      true,
+		}, {
+			name:         "retried",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			retries:      2,
+			wantStatuses: []string{"503", "200"},
+			wantSpans:    []codes.Code{codes.Error, codes.Unset},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[min(int(requests.Add(1))-1, len(tt.statuses)-1)]
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"protocol":"PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh","next_protocol":"PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh"}`))
+				} else {
+					_, _ = w.Write([]byte(http.StatusText(status)))
+				}
+			}))
+			defer server.Close()
+
+			link, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			var (
+				metrics   = newTestMetrics()
+				tracer    = new(testTracer)
+				observer  = new(requestObserver)
+				transport = http.DefaultTransport.(*http.Transport).Clone()
+			)
+			defer transport.CloseIdleConnections()
+
+			api := NewMainBlockRPC(server.URL,
+				WithObserver(NewMetricsObserver(metrics), NewTracingObserver(tracer), observer),
+				WithRetry(tt.retries, time.Millisecond, time.Millisecond),
+				WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					// request is sent with context returned by observers
+					tracer.mx.Lock()
+					span := tracer.spans[len(tracer.spans)-1]
+					tracer.mx.Unlock()
+					require.Same(t, span, trace.SpanFromContext(req.Context()))
+					return transport.RoundTrip(req)
+				})),
+			)
+			_, err = api.BlockProtocols(t.Context(), "head~2")
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, observer.infos, len(tt.wantStatuses))
+			for i, info := range observer.infos {
+				require.Equal(t, link.Host, info.Node)
+				require.Equal(t, http.MethodGet, info.Method)
+				require.Equal(t, endpoint, info.Endpoint)
+				require.Equal(t, "head~2", info.BlockID)
+				require.Equal(t, i+1, info.Attempt)
+			}
+
+			labels := map[string]string{
+				"node":     link.Host,
+				"endpoint": endpoint,
+				"method":   http.MethodGet,
+			}
+			var wantRequests []map[string]string
+			for _, status := range tt.wantStatuses {
+				wantRequests = append(wantRequests, map[string]string{
+					"node":     link.Host,
+					"endpoint": endpoint,
+					"method":   http.MethodGet,
+					"status":   status,
+				})
+			}
+			require.Equal(t, wantRequests, metrics.get(MetricRequests))
+			require.Equal(t, wantRequests, metrics.get(MetricRequestDuration))
+			require.Len(t, metrics.get(MetricResponseSize), len(tt.wantStatuses))
+			if len(tt.wantStatuses) > 1 {
+				require.Equal(t, []map[string]string{labels}, metrics.get(MetricRetries))
+			} else {
+				require.Empty(t, metrics.get(MetricRetries))
+			}
+
+			require.Len(t, tracer.spans, len(tt.wantSpans))
+			for i, span := range tracer.spans {
+				require.True(t, span.ended)
+				require.Equal(t, "node "+endpoint, span.name)
+				require.Equal(t, tt.wantSpans[i], span.status)
+				require.Equal(t, "chains/main/blocks/head~2/protocols", span.attrs["tezos.rpc.path"].AsString())
+				require.Equal(t, endpoint, span.attrs["tezos.rpc.endpoint"].AsString())
+				require.Equal(t, "head~2", span.attrs["tezos.block_id"].AsString())
+				require.Equal(t, link.Host, span.attrs["server.address"].AsString())
+				require.Equal(t, http.MethodGet, span.attrs["http.request.method"].AsString())
+				require.EqualValues(t, tt.statuses[i], span.attrs["http.response.status_code"].AsInt64())
+				require.Positive(t, span.attrs["http.response.body.size"].AsInt64())
+
+				resend, ok := span.attrs["http.request.resend_count"]
+				if i == 0 {
+					require.False(t, ok)
+				} else {
+					require.EqualValues(t, i, resend.AsInt64())
+				}
+			}
+		})
+	}
+}
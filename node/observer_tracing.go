@@ -0,0 +1,71 @@
+package node
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/dipdup-io/go-lib/node"
+
+// TracingObserver - creates OpenTelemetry client span for every request attempt.
+// Spans carry RPC path, endpoint, block identifier, status code and response size.
+type TracingObserver struct {
+	tracer trace.Tracer
+}
+
+var _ Observer = (*TracingObserver)(nil)
+
+// NewTracingObserver - creates observer with `tracer`. If it's nil, the tracer of global provider is used.
+func NewTracingObserver(tracer trace.Tracer) *TracingObserver {
+	if tracer == nil {
+		tracer = otel.Tracer(tracerName)
+	}
+	return &TracingObserver{tracer: tracer}
+}
+
+// OnRequest -
+func (t *TracingObserver) OnRequest(ctx context.Context, info RequestInfo) context.Context {
+	attrs := []attribute.KeyValue{
+		attribute.String("tezos.rpc.path", info.Path),
+		attribute.String("tezos.rpc.endpoint", info.Endpoint),
+		attribute.String("server.address", info.Node),
+		attribute.String("http.request.method", info.Method),
+	}
+	if info.BlockID != "" {
+		attrs = append(attrs, attribute.String("tezos.block_id", info.BlockID))
+	}
+	if info.Attempt > 1 {
+		attrs = append(attrs, attribute.Int("http.request.resend_count", info.Attempt-1))
+	}
+
+	ctx, _ = t.tracer.Start(ctx, "node "+info.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+// OnResponse -
+func (t *TracingObserver) OnResponse(ctx context.Context, info RequestInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if info.Err != nil {
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int("http.response.status_code", info.StatusCode),
+		attribute.Int64("http.response.body.size", info.ResponseSize),
+	)
+	if info.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(info.StatusCode))
+	}
+}
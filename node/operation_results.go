@@ -0,0 +1,163 @@
+package node
+
+import "iter"
+
+// IsApplied - returns true if operation was applied
+func (result OperationResult) IsApplied() bool {
+	return result.Status == OperationStatusApplied
+}
+
+// IsFailed - returns true if operation failed, was backtracked or skipped
+func (result OperationResult) IsFailed() bool {
+	return result.Status != "" && result.Status != OperationStatusApplied
+}
+
+// BigMapUpdates - iterates over big map diffs of the result. `Kind` and `ID` of the lazy storage diff are passed with every update.
+func (result OperationResult) BigMapUpdates() iter.Seq2[LazyStorageDiff, LazyStorageDiffUpdate] {
+	return func(yield func(LazyStorageDiff, LazyStorageDiffUpdate) bool) {
+		for i := range result.LazyStorageDiff {
+			diff := result.LazyStorageDiff[i]
+			if diff.Kind != LazyStorageDiffKindBigMap {
+				continue
+			}
+			for j := range diff.Diff.Updates {
+				if !yield(diff, diff.Diff.Updates[j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ManagerMetadata - returns metadata of manager operation. It returns false if operation is not a manager one or metadata is absent.
+func (op Operation) ManagerMetadata() (*ManagerOperationMetadata, bool) {
+	var metadata *ManagerOperationMetadata
+	switch body := op.Body.(type) {
+	case DalPublishCommitment:
+		metadata = body.Metadata
+	case Delegation:
+		metadata = body.Metadata
+	case DrainDelegate:
+		metadata = body.Metadata
+	case IncreasePaidStorage:
+		metadata = body.Metadata
+	case Origination:
+		metadata = body.Metadata
+	case RegisterGlobalConstant:
+		metadata = body.Metadata
+	case Reveal:
+		metadata = body.Metadata
+	case SetDepositsLimit:
+		metadata = body.Metadata
+	case SmartRollupAddMessage:
+		metadata = body.Metadata
+	case SmartRollupCement:
+		metadata = body.Metadata
+	case SmartRollupExecute:
+		metadata = body.Metadata
+	case SmartRollupOriginate:
+		metadata = body.Metadata
+	case SmartRollupPublish:
+		metadata = body.Metadata
+	case SmartRollupRecoverBond:
+		metadata = body.Metadata
+	case SmartRollupRefute:
+		metadata = body.Metadata
+	case SmartRollupTimeout:
+		metadata = body.Metadata
+	case Transaction:
+		metadata = body.Metadata
+	case TransferTicket:
+		metadata = body.Metadata
+	case TxRollupCommit:
+		metadata = body.Metadata
+	case TxRollupDispatchTickets:
+		metadata = body.Metadata
+	case TxRollupFinalizeCommitment:
+		metadata = body.Metadata
+	case TxRollupOrigination:
+		metadata = body.Metadata
+	case TxRollupRemoveCommitment:
+		metadata = body.Metadata
+	case TxRollupSubmitBatch:
+		metadata = body.Metadata
+	case UpdateConsensusKey:
+		metadata = body.Metadata
+	case VdfRevelation:
+		metadata = body.Metadata
+	}
+	return metadata, metadata != nil
+}
+
+// Result - returns result of manager operation
+func (op Operation) Result() (OperationResult, bool) {
+	metadata, ok := op.ManagerMetadata()
+	if !ok {
+		return OperationResult{}, false
+	}
+	return metadata.OperationResult, true
+}
+
+// InternalOperations - iterates over internal operations emitted by the operation in execution order
+func (op Operation) InternalOperations() iter.Seq2[int, InternalOperationResult] {
+	return func(yield func(int, InternalOperationResult) bool) {
+		metadata, ok := op.ManagerMetadata()
+		if !ok {
+			return
+		}
+		for i := range metadata.InternalOperationResults {
+			if !yield(i, metadata.InternalOperationResults[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Results - iterates over results of the operation and its internal operations in execution order.
+// The internal operation is nil for result of the operation itself.
+func (op Operation) Results() iter.Seq2[*InternalOperationResult, OperationResult] {
+	return func(yield func(*InternalOperationResult, OperationResult) bool) {
+		metadata, ok := op.ManagerMetadata()
+		if !ok {
+			return
+		}
+		if !yield(nil, metadata.OperationResult) {
+			return
+		}
+		for i := range metadata.InternalOperationResults {
+			internal := &metadata.InternalOperationResults[i]
+			if !yield(internal, internal.Result) {
+				return
+			}
+		}
+	}
+}
+
+// InternalOperations - iterates over internal operations of all group contents in execution order. The key is index of content in the group.
+func (group OperationGroup) InternalOperations() iter.Seq2[int, InternalOperationResult] {
+	return func(yield func(int, InternalOperationResult) bool) {
+		for i := range group.Contents {
+			for _, internal := range group.Contents[i].InternalOperations() {
+				if !yield(i, internal) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// InternalOperations - iterates over internal operations of all block operations in execution order
+func (block Block) InternalOperations() iter.Seq2[*OperationGroup, InternalOperationResult] {
+	return func(yield func(*OperationGroup, InternalOperationResult) bool) {
+		for i := range block.Operations {
+			for j := range block.Operations[i] {
+				group := &block.Operations[i][j]
+				for _, internal := range group.InternalOperations() {
+					if !yield(group, internal) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// receiptGroup - applied group of a contract call emitting transaction, origination and event followed by a reveal
+const receiptGroup = `{
+	"protocol": "PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg",
+	"chain_id": "NetXdQprcVkpaWU",
+	"hash": "opHash",
+	"branch": "BLockHash0",
+	"signature": "sigSignature",
+	"contents": [
+		{
+			"kind": "transaction",
+			"source": "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+			"fee": "1000",
+			"counter": "10",
+			"gas_limit": "10000",
+			"storage_limit": "1000",
+			"amount": "0",
+			"destination": "KT1Contract",
+			"parameters": {"entrypoint": "shield", "value": {"prim": "Unit"}},
+			"metadata": {
+				"balance_updates": [],
+				"operation_result": {
+					"status": "applied",
+					"consumed_milligas": "1000000",
+					"lazy_storage_diff": [
+						{"kind": "big_map", "id": "5", "diff": {"action": "update", "updates": [
+							{"key_hash": "exprA", "key": {"int": "1"}, "value": {"int": "10"}},
+							{"key_hash": "exprB", "key": {"int": "2"}}
+						]}},
+						{"kind": "sapling_state", "id": "17", "diff": {"action": "update", "updates": {
+							"commitments_and_ciphertexts": [["0a0b", {"cv": "01", "epk": "02", "payload_enc": "03", "nonce_enc": "04", "payload_out": "05", "nonce_out": "06"}]],
+							"nullifiers": ["0c0d"]
+						}, "memo_size": 8}},
+						{"kind": "big_map", "id": "6", "diff": {"action": "alloc", "updates": [
+							{"key_hash": "exprC", "key": {"string": "c"}, "value": {"int": "3"}}
+						], "key_type": {"prim": "string"}, "value_type": {"prim": "int"}}}
+					]
+				},
+				"internal_operation_results": [
+					{
+						"kind": "transaction",
+						"source": "KT1Contract",
+						"nonce": 0,
+						"amount": "100",
+						"destination": "KT1Receiver",
+						"parameters": {"entrypoint": "default", "value": {"prim": "Unit"}},
+						"result": {
+							"status": "applied",
+							"lazy_storage_diff": [
+								{"kind": "big_map", "id": "7", "diff": {"action": "update", "updates": [{"key_hash": "exprD", "key": {"int": "4"}}]}}
+							]
+						}
+					},
+					{
+						"kind": "origination",
+						"source": "KT1Contract",
+						"nonce": 1,
+						"balance": "0",
+						"script": {"code": [], "storage": {"prim": "Unit"}},
+						"result": {"status": "applied", "originated_contracts": ["KT1Originated"]}
+					},
+					{
+						"kind": "event",
+						"source": "KT1Contract",
+						"nonce": 2,
+						"type": {"prim": "nat"},
+						"tag": "minted",
+						"payload": {"int": "1"},
+						"result": {"status": "applied", "consumed_milligas": "100000"}
+					}
+				]
+			}
+		},
+		{
+			"kind": "reveal",
+			"source": "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+			"fee": "1000",
+			"counter": "11",
+			"gas_limit": "1000",
+			"storage_limit": "0",
+			"public_key": "edpkPublicKey",
+			"metadata": {"balance_updates": [], "operation_result": {"status": "applied"}}
+		}
+	]
+}`
+
+func receiptFixture(t *testing.T) OperationGroup {
+	t.Helper()
+	var group OperationGroup
+	require.NoError(t, json.UnmarshalFromString(receiptGroup, &group))
+	require.Len(t, group.Contents, 2)
+	return group
+}
+
+func TestOperation_Results(t *testing.T) {
+	operation := receiptFixture(t).Contents[0]
+
+	var (
+		kinds    []string
+		statuses []string
+	)
+	for internal, result := range operation.Results() {
+		if internal == nil {
+			kinds = append(kinds, "")
+		} else {
+			kinds = append(kinds, internal.Kind)
+		}
+		statuses = append(statuses, result.Status)
+	}
+	require.Equal(t, []string{"", KindTransaction, KindOrigination, KindEvent}, kinds)
+	require.Equal(t, []string{OperationStatusApplied, OperationStatusApplied, OperationStatusApplied, OperationStatusApplied}, statuses)
+
+	var count int
+	for internal, result := range operation.Results() {
+		count++
+		if internal != nil {
+			require.Equal(t, []string{"exprD"}, collectKeyHashes(result))
+			break
+		}
+	}
+	require.Equal(t, 2, count)
+
+	for range (Operation{Kind: KindEndorsement, Body: Endorsement{}}).Results() {
+		require.FailNow(t, "results of non-manager operation")
+	}
+}
+
+func TestOperationResult_BigMapUpdates(t *testing.T) {
+	result, ok := receiptFixture(t).Contents[0].Result()
+	require.True(t, ok)
+	require.True(t, result.IsApplied())
+	require.False(t, result.IsFailed())
+
+	var ids []string
+	for diff, update := range result.BigMapUpdates() {
+		require.Equal(t, LazyStorageDiffKindBigMap, diff.Kind)
+		ids = append(ids, diff.ID+":"+update.KeyHash)
+	}
+	// sapling diff is skipped
+	require.Equal(t, []string{"5:exprA", "5:exprB", "6:exprC"}, ids)
+
+	ids = ids[:0]
+	for diff, update := range result.BigMapUpdates() {
+		ids = append(ids, diff.ID+":"+update.KeyHash)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	require.Equal(t, []string{"5:exprA", "5:exprB"}, ids)
+}
+
+func TestOperation_InternalOperations(t *testing.T) {
+	operation := receiptFixture(t).Contents[0]
+
+	var internals []InternalOperationResult
+	for i, internal := range operation.InternalOperations() {
+		require.Equal(t, len(internals), i)
+		internals = append(internals, internal)
+	}
+	require.Len(t, internals, 3)
+
+	transaction, err := NewTypedOperation[Transaction](internals[0].Operation())
+	require.NoError(t, err)
+	require.Equal(t, "KT1Contract", transaction.Source)
+	require.Equal(t, "KT1Receiver", transaction.Destination)
+	require.Equal(t, "100", transaction.Amount)
+	require.NotNil(t, transaction.Parameters)
+	require.Equal(t, "default", transaction.Parameters.Entrypoint)
+
+	require.EqualValues(t, 1, internals[1].Nonce)
+	require.Equal(t, []string{"KT1Originated"}, internals[1].Result.OriginatedContracts)
+	origination, err := NewTypedOperation[Origination](internals[1].Operation())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"code": [], "storage": {"prim": "Unit"}}`, string(origination.Script))
+
+	event, err := NewTypedOperation[Event](internals[2].Operation())
+	require.NoError(t, err)
+	require.Equal(t, "minted", event.Tag)
+	require.Equal(t, "100000", event.Result.ConsumedMilligas)
+
+	var count int
+	for range operation.InternalOperations() {
+		count++
+		break
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestBlock_InternalOperations(t *testing.T) {
+	group := receiptFixture(t)
+	block := Block{
+		Operations: [][]OperationGroup{{}, {}, {}, {group, group}},
+	}
+
+	var indices []int
+	for i, internal := range group.InternalOperations() {
+		require.Equal(t, "KT1Contract", internal.Source)
+		indices = append(indices, i)
+	}
+	// the reveal has no internal operations
+	require.Equal(t, []int{0, 0, 0}, indices)
+
+	var nonces []uint64
+	for g, internal := range block.InternalOperations() {
+		require.Same(t, &block.Operations[3][len(nonces)/3], g)
+		nonces = append(nonces, internal.Nonce)
+	}
+	require.Equal(t, []uint64{0, 1, 2, 0, 1, 2}, nonces)
+
+	nonces = nonces[:0]
+	for _, internal := range block.InternalOperations() {
+		nonces = append(nonces, internal.Nonce)
+		if len(nonces) == 4 {
+			break
+		}
+	}
+	require.Equal(t, []uint64{0, 1, 2, 0}, nonces)
+}
+
+func collectKeyHashes(result OperationResult) []string {
+	var hashes []string
+	for _, update := range result.BigMapUpdates() {
+		hashes = append(hashes, update.KeyHash)
+	}
+	return hashes
+}
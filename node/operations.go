@@ -18,7 +18,8 @@ type OperationConstraint interface {
 		TxRollupRemoveCommitment | TxRollupSubmitBatch | UpdateConsensusKey |
 		DrainDelegate | SmartRollupAddMessage | SmartRollupCement | SmartRollupExecute |
 		SmartRollupOriginate | SmartRollupPublish | SmartRollupRecoverBond |
-		SmartRollupRefute | SmartRollupTimeout | DalPublishCommitment
+		SmartRollupRefute | SmartRollupTimeout | DalPublishCommitment |
+		IncreasePaidStorage | TransferTicket
 }
 
 // OperationGroup -
@@ -45,7 +46,7 @@ func (op *Operation) UnmarshalJSON(data []byte) error {
 	}
 
 	var err error
-	switch op.Kind {
+	switch NormalizeKind(op.Kind) {
 	case KindActivation:
 		err = parseOperation[AccountActivation](data, op)
 	case KindBallot:
@@ -120,11 +121,39 @@ func (op *Operation) UnmarshalJSON(data []byte) error {
 		err = parseOperation[SmartRollupTimeout](data, op)
 	case KindDalPublishCommitment:
 		err = parseOperation[DalPublishCommitment](data, op)
+	case KindIncreasePaidStorage:
+		err = parseOperation[IncreasePaidStorage](data, op)
+	case KindTransferTicket:
+		err = parseOperation[TransferTicket](data, op)
 
 	}
 	return err
 }
 
+// MarshalJSON - marshals operation body with its kind. Empty metadata is omitted, so unsigned operations can be sent to node.
+func (op Operation) MarshalJSON() ([]byte, error) {
+	if op.Body == nil {
+		return json.Marshal(map[string]string{"kind": op.Kind})
+	}
+	data, err := json.Marshal(op.Body)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]stdJSON.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if metadata, ok := fields["metadata"]; ok && bytes.Equal(metadata, []byte("null")) {
+		delete(fields, "metadata")
+	}
+	kind, err := json.Marshal(op.Kind)
+	if err != nil {
+		return nil, err
+	}
+	fields["kind"] = kind
+	return json.Marshal(fields)
+}
+
 func parseOperation[M OperationConstraint](data []byte, operation *Operation) error {
 	var model M
 	if err := json.Unmarshal(data, &model); err != nil {
@@ -163,10 +192,13 @@ type Ballot struct {
 	Ballot   string `json:"ballot"`
 }
 
-// Endorsement -
+// Endorsement - endorsement or attestation. `Slot`, `Round` and `BlockPayloadHash` are filled since Ithaca.
 type Endorsement struct {
-	Level    uint64               `json:"level"`
-	Metadata *EndorsementMetadata `json:"metadata,omitempty"`
+	Slot             uint64               `json:"slot"`
+	Level            uint64               `json:"level"`
+	Round            int64                `json:"round"`
+	BlockPayloadHash string               `json:"block_payload_hash,omitempty"`
+	Metadata         *EndorsementMetadata `json:"metadata,omitempty"`
 }
 
 // EndorsementWithSlot -
@@ -189,19 +221,45 @@ type EndorsementWithDal struct {
 
 // Preendorsement -
 type Preendorsement struct {
-	Slot             uint64 `json:"slot"`
-	Level            uint64 `json:"level"`
-	Round            int64  `json:"round"`
-	BlockPayloadHash string `json:"block_payload_hash"`
-	Metadata         struct {
-		BalanceUpdates      []interface{} `json:"balance_updates"`
-		Delegate            string        `json:"delegate"`
-		PreendorsementPower int           `json:"preendorsement_power"`
-	} `json:"metadata"`
+	Slot             uint64                 `json:"slot"`
+	Level            uint64                 `json:"level"`
+	Round            int64                  `json:"round"`
+	BlockPayloadHash string                 `json:"block_payload_hash"`
+	Metadata         PreendorsementMetadata `json:"metadata"`
+}
+
+// PreendorsementMetadata -
+type PreendorsementMetadata struct {
+	BalanceUpdates      []interface{} `json:"balance_updates"`
+	Delegate            string        `json:"delegate"`
+	ConsensusKey        string        `json:"consensus_key,omitempty"`
+	PreendorsementPower int           `json:"preendorsement_power"`
+}
+
+// UnmarshalJSON - reads power from `preendorsement_power`, `preattestation_power` or `consensus_power` depending on protocol
+func (m *PreendorsementMetadata) UnmarshalJSON(data []byte) error {
+	type buf PreendorsementMetadata
+	var value struct {
+		buf
+		PreattestationPower *int `json:"preattestation_power"`
+		ConsensusPower      *int `json:"consensus_power"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*m = PreendorsementMetadata(value.buf)
+	switch {
+	case value.PreattestationPower != nil:
+		m.PreendorsementPower = *value.PreattestationPower
+	case value.ConsensusPower != nil:
+		m.PreendorsementPower = *value.ConsensusPower
+	}
+	return nil
 }
 
 // Delegation -
 type Delegation struct {
+	Kind         string                    `json:"kind"`
 	Source       string                    `json:"source"`
 	Fee          string                    `json:"fee"`
 	Counter      string                    `json:"counter"`
@@ -234,6 +292,7 @@ type DoublePreendorsementEvidence struct {
 
 // Origination -
 type Origination struct {
+	Kind          string                    `json:"kind"`
 	Source        string                    `json:"source"`
 	Fee           string                    `json:"fee"`
 	Counter       string                    `json:"counter"`
@@ -255,23 +314,27 @@ type Proposal struct {
 
 // Reveal -
 type Reveal struct {
+	Kind         string                    `json:"kind"`
 	Source       string                    `json:"source"`
 	Fee          string                    `json:"fee"`
 	Counter      string                    `json:"counter"`
 	GasLimit     string                    `json:"gas_limit"`
 	StorageLimit string                    `json:"storage_limit"`
 	PublicKey    string                    `json:"public_key"`
+	Proof        string                    `json:"proof,omitempty"`
 	Metadata     *ManagerOperationMetadata `json:"metadata,omitempty"`
 }
 
 // RegisterGlobalConstant -
 type RegisterGlobalConstant struct {
-	Source       string             `json:"source"`
-	Fee          string             `json:"fee"`
-	Counter      string             `json:"counter"`
-	GasLimit     string             `json:"gas_limit"`
-	StorageLimit string             `json:"storage_limit"`
-	Value        stdJSON.RawMessage `json:"value"`
+	Kind         string                    `json:"kind"`
+	Source       string                    `json:"source"`
+	Fee          string                    `json:"fee"`
+	Counter      string                    `json:"counter"`
+	GasLimit     string                    `json:"gas_limit"`
+	StorageLimit string                    `json:"storage_limit"`
+	Value        stdJSON.RawMessage        `json:"value"`
+	Metadata     *ManagerOperationMetadata `json:"metadata,omitempty"`
 }
 
 // SeedNonceRevelation -
@@ -313,6 +376,7 @@ type VdfRevelation struct {
 
 // Transaction -
 type Transaction struct {
+	Kind         string                    `json:"kind"`
 	Source       string                    `json:"source"`
 	Fee          string                    `json:"fee"`
 	Counter      string                    `json:"counter"`
@@ -332,9 +396,54 @@ type Parameters struct {
 
 // ManagerOperationMetadata -
 type ManagerOperationMetadata struct {
-	BalanceUpdates           []BalanceUpdate `json:"balance_updates"`
-	OperationResult          OperationResult `json:"operation_result"`
-	InternalOperationResults []Operation     `json:"internal_operation_results,omitempty"`
+	BalanceUpdates           []BalanceUpdate           `json:"balance_updates"`
+	OperationResult          OperationResult           `json:"operation_result"`
+	InternalOperationResults []InternalOperationResult `json:"internal_operation_results,omitempty"`
+}
+
+// InternalOperationResult - internal operation with its result. `Body` is the operation decoded to typed model as in `Operation`.
+type InternalOperationResult struct {
+	InternalOperation
+	Result OperationResult `json:"result"`
+	Body   interface{}     `json:"-"`
+}
+
+// UnmarshalJSON -
+func (op *InternalOperationResult) UnmarshalJSON(data []byte) error {
+	type buf InternalOperationResult
+	if err := json.Unmarshal(data, (*buf)(op)); err != nil {
+		return err
+	}
+	var operation Operation
+	if err := json.Unmarshal(data, &operation); err != nil {
+		return err
+	}
+	op.Body = operation.Body
+	return nil
+}
+
+// Operation - returns internal operation with typed body, so it can be passed to `NewTypedOperation`
+func (op InternalOperationResult) Operation() Operation {
+	return Operation{
+		Kind: op.Kind,
+		Body: op.Body,
+	}
+}
+
+// InternalOperation - operation emitted by contract
+type InternalOperation struct {
+	Kind        string             `json:"kind"`
+	Source      string             `json:"source"`
+	Nonce       uint64             `json:"nonce"`
+	Amount      string             `json:"amount,omitempty"`
+	Destination string             `json:"destination,omitempty"`
+	Parameters  *Parameters        `json:"parameters,omitempty"`
+	Balance     string             `json:"balance,omitempty"`
+	Delegate    string             `json:"delegate,omitempty"`
+	Script      stdJSON.RawMessage `json:"script,omitempty"`
+	Type        stdJSON.RawMessage `json:"type,omitempty"`
+	Tag         string             `json:"tag,omitempty"`
+	Payload     stdJSON.RawMessage `json:"payload,omitempty"`
 }
 
 // OnlyBalanceUpdatesMetadata -
@@ -351,6 +460,27 @@ type EndorsementMetadata struct {
 	Slots            []int           `json:"slots,omitempty"`
 }
 
+// UnmarshalJSON - reads power from `endorsement_power`, `attestation_power` or `consensus_power` depending on protocol
+func (m *EndorsementMetadata) UnmarshalJSON(data []byte) error {
+	type buf EndorsementMetadata
+	var value struct {
+		buf
+		AttestationPower *int `json:"attestation_power"`
+		ConsensusPower   *int `json:"consensus_power"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*m = EndorsementMetadata(value.buf)
+	switch {
+	case value.AttestationPower != nil:
+		m.EndorsementPower = *value.AttestationPower
+	case value.ConsensusPower != nil:
+		m.EndorsementPower = *value.ConsensusPower
+	}
+	return nil
+}
+
 // OperationResult -
 type OperationResult struct {
 	Status                       string              `json:"status"`
@@ -378,6 +508,17 @@ type ResultError struct {
 	Location       int64               `json:"location,omitempty"`
 	ContractHandle string              `json:"contract_handle,omitempty"`
 	ContractCode   *stdJSON.RawMessage `json:"contract_code,omitempty"`
+	Raw            stdJSON.RawMessage  `json:"-"`
+}
+
+// UnmarshalJSON -
+func (e *ResultError) UnmarshalJSON(data []byte) error {
+	type buf ResultError
+	if err := json.Unmarshal(data, (*buf)(e)); err != nil {
+		return err
+	}
+	e.Raw = data
+	return nil
 }
 
 // BigMapDiff is an element of BigMapDiffs
@@ -393,11 +534,59 @@ type BigMapDiff struct {
 	ValueType         *stdJSON.RawMessage `json:"value_type,omitempty"`
 }
 
-// LazyStorageDiff -
+// LazyStorageDiff - diff of big map or sapling state. `Diff` is filled for big maps and `SaplingDiff` for sapling states.
 type LazyStorageDiff struct {
-	Kind string                      `json:"kind"`
-	ID   string                      `json:"id"`
-	Diff LazyStorageDiffBigMapEntity `json:"diff"`
+	Kind        string                        `json:"kind"`
+	ID          string                        `json:"id"`
+	Diff        LazyStorageDiffBigMapEntity   `json:"diff"`
+	SaplingDiff *LazyStorageDiffSaplingEntity `json:"-"`
+}
+
+type lazyStorageDiffBuf struct {
+	Kind string             `json:"kind"`
+	ID   string             `json:"id"`
+	Diff stdJSON.RawMessage `json:"diff,omitempty"`
+}
+
+// UnmarshalJSON -
+func (d *LazyStorageDiff) UnmarshalJSON(data []byte) error {
+	var buf lazyStorageDiffBuf
+	if err := json.Unmarshal(data, &buf); err != nil {
+		return err
+	}
+	d.Kind = buf.Kind
+	d.ID = buf.ID
+	if len(buf.Diff) == 0 {
+		return nil
+	}
+
+	if d.Kind == LazyStorageDiffKindSapling {
+		d.SaplingDiff = new(LazyStorageDiffSaplingEntity)
+		return json.Unmarshal(buf.Diff, d.SaplingDiff)
+	}
+	return json.Unmarshal(buf.Diff, &d.Diff)
+}
+
+// MarshalJSON -
+func (d LazyStorageDiff) MarshalJSON() ([]byte, error) {
+	var (
+		diff []byte
+		err  error
+	)
+	switch {
+	case d.Kind != LazyStorageDiffKindSapling:
+		diff, err = json.Marshal(d.Diff)
+	case d.SaplingDiff != nil:
+		diff, err = json.Marshal(d.SaplingDiff)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(lazyStorageDiffBuf{
+		Kind: d.Kind,
+		ID:   d.ID,
+		Diff: diff,
+	})
 }
 
 // LazyStorageDiffBigMapEntity -
@@ -409,25 +598,26 @@ type LazyStorageDiffBigMapEntity struct {
 	ValueType *stdJSON.RawMessage     `json:"value_type,omitempty"`
 }
 
-// LazyStorageDiffUpdate -
+// LazyStorageDiffUpdate - update of big map key. `Value` is nil if the key is removed.
 type LazyStorageDiffUpdate struct {
-	Action string              `json:"action,omitempty"`
-	Key    *stdJSON.RawMessage `json:"key,omitempty"`
-	Value  *stdJSON.RawMessage `json:"value,omitempty"`
+	Action  string              `json:"action,omitempty"`
+	KeyHash string              `json:"key_hash,omitempty"`
+	Key     *stdJSON.RawMessage `json:"key,omitempty"`
+	Value   *stdJSON.RawMessage `json:"value,omitempty"`
 }
 
 // LazyStorageDiffSaplingEntity -
 type LazyStorageDiffSaplingEntity struct {
-	Action   string                  `json:"action"`
-	Updates  []LazyStorageDiffUpdate `json:"updates,omitempty"`
-	Source   string                  `json:"source,omitempty"`
-	MemoSize uint64                  `json:"memo_size,omitempty"`
+	Action   string                              `json:"action"`
+	Updates  *LazyStorageDiffUpdatesSaplingState `json:"updates,omitempty"`
+	Source   string                              `json:"source,omitempty"`
+	MemoSize uint64                              `json:"memo_size,omitempty"`
 }
 
 // LazyStorageDiffUpdatesSaplingState -
 type LazyStorageDiffUpdatesSaplingState struct {
-	CommitmentsAndCiphertexts CommitmentsAndCiphertexts `json:"commitments_and_ciphertexts,omitempty"`
-	Nullifiers                []string                  `json:"nullifiers,omitempty"`
+	CommitmentsAndCiphertexts []CommitmentsAndCiphertexts `json:"commitments_and_ciphertexts,omitempty"`
+	Nullifiers                []string                    `json:"nullifiers,omitempty"`
 }
 
 // CommitmentsAndCiphertexts-
@@ -438,10 +628,17 @@ type CommitmentsAndCiphertexts struct {
 
 // UnmarshalJSON -
 func (cc *CommitmentsAndCiphertexts) UnmarshalJSON(data []byte) error {
-	raw := []interface{}{
-		cc.Commitments, cc.Ciphertexts,
+	var raw []stdJSON.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return errors.Errorf("invalid commitments and ciphertexts length: %d", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &cc.Commitments); err != nil {
+		return err
 	}
-	return json.Unmarshal(data, &raw)
+	return json.Unmarshal(raw[1], &cc.Ciphertexts)
 }
 
 // MarshalJSON -
@@ -484,8 +681,11 @@ type InlinedEndorsement struct {
 
 // InlinedEndorsementOperations -
 type InlinedEndorsementOperations struct {
-	Kind  string `json:"kind"`
-	Level int    `json:"level"`
+	Kind             string `json:"kind"`
+	Slot             uint64 `json:"slot,omitempty"`
+	Level            int    `json:"level"`
+	Round            int64  `json:"round,omitempty"`
+	BlockPayloadHash string `json:"block_payload_hash,omitempty"`
 }
 
 // InlinedPreendorsement -
@@ -675,6 +875,7 @@ type UpdateConsensusKey struct {
 	GasLimit     string                    `json:"gas_limit"`
 	StorageLimit string                    `json:"storage_limit"`
 	Pk           string                    `json:"pk"`
+	Proof        string                    `json:"proof,omitempty"`
 	Metadata     *ManagerOperationMetadata `json:"metadata,omitempty"`
 }
 
@@ -697,8 +898,9 @@ type SmartRollupOriginate struct {
 	StorageLimit     string                    `json:"storage_limit"`
 	PvmKind          string                    `json:"pvm_kind"`
 	Kernel           string                    `json:"kernel"`
-	OriginationProof string                    `json:"origination_proof"`
-	ParametersTy     string                    `json:"parameters_ty"`
+	OriginationProof string                    `json:"origination_proof,omitempty"`
+	ParametersTy     stdJSON.RawMessage        `json:"parameters_ty"`
+	Whitelist        []string                  `json:"whitelist,omitempty"`
 	Metadata         *ManagerOperationMetadata `json:"metadata,omitempty"`
 }
 
@@ -806,6 +1008,37 @@ type DalPublishCommitment struct {
 	Metadata     *ManagerOperationMetadata `json:"metadata,omitempty"`
 }
 
+// IncreasePaidStorage -
+type IncreasePaidStorage struct {
+	Kind         string                    `json:"kind"`
+	Source       string                    `json:"source"`
+	Fee          string                    `json:"fee"`
+	Counter      string                    `json:"counter"`
+	GasLimit     string                    `json:"gas_limit"`
+	StorageLimit string                    `json:"storage_limit"`
+	Amount       string                    `json:"amount"`
+	Destination  string                    `json:"destination"`
+	Metadata     *ManagerOperationMetadata `json:"metadata,omitempty"`
+}
+
+// TransferTicket -
+type TransferTicket struct {
+	Kind           string                    `json:"kind"`
+	Source         string                    `json:"source"`
+	Fee            string                    `json:"fee"`
+	Counter        string                    `json:"counter"`
+	GasLimit       string                    `json:"gas_limit"`
+	StorageLimit   string                    `json:"storage_limit"`
+	TicketContents stdJSON.RawMessage        `json:"ticket_contents"`
+	TicketType     stdJSON.RawMessage        `json:"ticket_ty"`
+	TicketTicketer string                    `json:"ticket_ticketer"`
+	TicketAmount   string                    `json:"ticket_amount"`
+	Destination    string                    `json:"destination"`
+	Entrypoint     string                    `json:"entrypoint"`
+	Metadata       *ManagerOperationMetadata `json:"metadata,omitempty"`
+}
+
+// SlotHeader -
 type SlotHeader struct {
 	SlotIndex       int    `json:"slot_index"`
 	Commitment      string `json:"commitment"`
@@ -0,0 +1,218 @@
+package node
+
+import (
+	stdJSON "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndorsementMetadata_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want int
+	}{
+		{
+			name: "endorsement",
+			data: `{"kind":"endorsement","slot":0,"level":2490367,"round":0,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"balance_updates":[],"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","endorsement_power":326}}`,
+			want: 326,
+		}, {
+			name: "attestation",
+			data: `{"kind":"attestation","slot":3,"level":4100000,"round":0,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_key":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","attestation_power":280}}`,
+			want: 280,
+		}, {
+			name: "attestation with consensus power",
+			data: `{"kind":"attestation","slot":3,"level":6000000,"round":1,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_key":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_power":301}}`,
+			want: 301,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operation Operation
+			require.NoError(t, json.UnmarshalFromString(tt.data, &operation))
+
+			endorsement, err := NewTypedOperation[Endorsement](operation)
+			require.NoError(t, err)
+			require.NotNil(t, endorsement.Metadata)
+			require.Equal(t, "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", endorsement.Metadata.Delegate)
+			require.Equal(t, tt.want, endorsement.Metadata.EndorsementPower)
+		})
+	}
+}
+
+func TestPreendorsementMetadata_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want int
+	}{
+		{
+			name: "preendorsement",
+			data: `{"kind":"preendorsement","slot":0,"level":2490367,"round":1,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"balance_updates":[],"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","preendorsement_power":326}}`,
+			want: 326,
+		}, {
+			name: "preattestation",
+			data: `{"kind":"preattestation","slot":3,"level":4100000,"round":1,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_key":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","preattestation_power":280}}`,
+			want: 280,
+		}, {
+			name: "preattestation with consensus power",
+			data: `{"kind":"preattestation","slot":3,"level":6000000,"round":1,"block_payload_hash":"vh2TyrWeZ2dydEy9ZjmvrjQvyCs5tyQyTE8ZoNF4qgVTe8aZXQ4y","metadata":{"delegate":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_key":"tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk","consensus_power":301}}`,
+			want: 301,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operation Operation
+			require.NoError(t, json.UnmarshalFromString(tt.data, &operation))
+
+			preendorsement, err := NewTypedOperation[Preendorsement](operation)
+			require.NoError(t, err)
+			require.Equal(t, "tz1irJKkXS2DBWkU1NnmFQx1c1L7pbGg4yhk", preendorsement.Metadata.Delegate)
+			require.Equal(t, tt.want, preendorsement.Metadata.PreendorsementPower)
+		})
+	}
+}
+
+func TestLazyStorageDiff_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    LazyStorageDiff
+		wantErr bool
+	}{
+		{
+			name: "big map",
+			data: `{"kind":"big_map","id":"5","diff":{"action":"update","updates":[{"key_hash":"exprA","key":{"int":"1"}}]}}`,
+			want: LazyStorageDiff{
+				Kind: LazyStorageDiffKindBigMap,
+				ID:   "5",
+				Diff: LazyStorageDiffBigMapEntity{
+					Action:  "update",
+					Updates: []LazyStorageDiffUpdate{{KeyHash: "exprA", Key: rawMessage(`{"int":"1"}`)}},
+				},
+			},
+		}, {
+			name: "sapling state",
+			data: `{"kind":"sapling_state","id":"17","diff":{"action":"alloc","updates":{"commitments_and_ciphertexts":[["0a0b",{"cv":"01","epk":"02","payload_enc":"03","nonce_enc":"04","payload_out":"05","nonce_out":"06"}]],"nullifiers":["0c0d"]},"memo_size":8}}`,
+			want: LazyStorageDiff{
+				Kind: LazyStorageDiffKindSapling,
+				ID:   "17",
+				SaplingDiff: &LazyStorageDiffSaplingEntity{
+					Action:   "alloc",
+					MemoSize: 8,
+					Updates: &LazyStorageDiffUpdatesSaplingState{
+						CommitmentsAndCiphertexts: []CommitmentsAndCiphertexts{
+							{
+								Commitments: "0a0b",
+								Ciphertexts: SaplingTransactionCiphertext{CV: "01", EPK: "02", PayloadEnc: "03", NonceEnc: "04", PayloadOut: "05", NonceOut: "06"},
+							},
+						},
+						Nullifiers: []string{"0c0d"},
+					},
+				},
+			},
+		}, {
+			name: "sapling state copy",
+			data: `{"kind":"sapling_state","id":"18","diff":{"action":"copy","source":"17","updates":{}}}`,
+			want: LazyStorageDiff{
+				Kind: LazyStorageDiffKindSapling,
+				ID:   "18",
+				SaplingDiff: &LazyStorageDiffSaplingEntity{
+					Action:  "copy",
+					Source:  "17",
+					Updates: &LazyStorageDiffUpdatesSaplingState{},
+				},
+			},
+		}, {
+			name: "without diff",
+			data: `{"kind":"sapling_state","id":"19"}`,
+			want: LazyStorageDiff{
+				Kind: LazyStorageDiffKindSapling,
+				ID:   "19",
+			},
+		}, {
+			name:    "invalid commitments and ciphertexts",
+			data:    `{"kind":"sapling_state","id":"17","diff":{"action":"update","updates":{"commitments_and_ciphertexts":[["0a0b"]]}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diff LazyStorageDiff
+			err := json.UnmarshalFromString(tt.data, &diff)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, diff)
+
+			data, err := json.Marshal(diff)
+			require.NoError(t, err)
+
+			var decoded LazyStorageDiff
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			require.Equal(t, diff, decoded)
+		})
+	}
+}
+
+func TestCommitmentsAndCiphertexts_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    CommitmentsAndCiphertexts
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: `["0a0b",{"cv":"01","epk":"02","payload_enc":"03","nonce_enc":"04","payload_out":"05","nonce_out":"06"}]`,
+			want: CommitmentsAndCiphertexts{
+				Commitments: "0a0b",
+				Ciphertexts: SaplingTransactionCiphertext{CV: "01", EPK: "02", PayloadEnc: "03", NonceEnc: "04", PayloadOut: "05", NonceOut: "06"},
+			},
+		}, {
+			name:    "one item",
+			data:    `["0a0b"]`,
+			wantErr: true,
+		}, {
+			name:    "three items",
+			data:    `["0a0b",{},{}]`,
+			wantErr: true,
+		}, {
+			name:    "commitment is not string",
+			data:    `[1,{}]`,
+			wantErr: true,
+		}, {
+			name:    "ciphertexts is not object",
+			data:    `["0a0b","01"]`,
+			wantErr: true,
+		}, {
+			name:    "object",
+			data:    `{"commitments":"0a0b"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cc CommitmentsAndCiphertexts
+			err := json.UnmarshalFromString(tt.data, &cc)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, cc)
+
+			data, err := json.Marshal(cc)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.data, string(data))
+		})
+	}
+}
+
+func rawMessage(value string) *stdJSON.RawMessage {
+	raw := stdJSON.RawMessage(value)
+	return &raw
+}
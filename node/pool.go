@@ -0,0 +1,290 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// default pool parameters
+const (
+	DefaultPoolMaxLag        = 2
+	DefaultPoolCheckInterval = 10 * time.Second
+)
+
+// PoolOption -
+type PoolOption func(*Pool)
+
+// WithMaxLag - sets maximum count of levels node may be behind the highest head in the pool to receive requests
+func WithMaxLag(levels uint64) PoolOption {
+	return func(p *Pool) {
+		p.maxLag = levels
+	}
+}
+
+// WithCheckInterval - sets interval of health checks
+func WithCheckInterval(interval time.Duration) PoolOption {
+	return func(p *Pool) {
+		if interval > 0 {
+			p.checkInterval = interval
+		}
+	}
+}
+
+// WithClientOptions - sets options of HTTP clients created for pool endpoints
+func WithClientOptions(opts ...ClientOption) PoolOption {
+	return func(p *Pool) {
+		p.clientOptions = append(p.clientOptions, opts...)
+	}
+}
+
+type poolNode struct {
+	api API
+
+	mx           sync.RWMutex
+	healthy      bool
+	bootstrapped bool
+	level        uint64
+	err          error
+}
+
+func (n *poolNode) setFailed(err error) {
+	n.mx.Lock()
+	n.healthy = false
+	n.err = err
+	n.mx.Unlock()
+}
+
+// NodeStatus - health state of pool endpoint
+type NodeStatus struct {
+	URL          string
+	Healthy      bool
+	Bootstrapped bool
+	Level        uint64
+	Err          error
+}
+
+// Pool - implementation of `API` which routes requests to several nodes. Requests are sent to bootstrapped nodes
+// which are not lagging behind the highest known head. If a node fails, the request is repeated on the next one.
+type Pool struct {
+	nodes         []*poolNode
+	maxLag        uint64
+	checkInterval time.Duration
+	clientOptions []ClientOption
+
+	next    atomic.Uint64
+	started atomic.Bool
+	wg      sync.WaitGroup
+}
+
+var _ API = (*Pool)(nil)
+
+// NewPool - creates pool over `urls` with the chain `chainID`
+func NewPool(urls []string, chainID string, opts ...PoolOption) (*Pool, error) {
+	pool := newPool(opts...)
+	if len(urls) == 0 {
+		return nil, errors.New("empty endpoint list")
+	}
+	for i := range urls {
+		pool.nodes = append(pool.nodes, &poolNode{
+			api:     NewRPC(urls[i], chainID, pool.clientOptions...),
+			healthy: true,
+		})
+	}
+	return pool, nil
+}
+
+// NewMainPool -
+func NewMainPool(urls []string, opts ...PoolOption) (*Pool, error) {
+	return NewPool(urls, "main", opts...)
+}
+
+// NewPoolFromAPI - creates pool over already created API implementations
+func NewPoolFromAPI(apis []API, opts ...PoolOption) (*Pool, error) {
+	pool := newPool(opts...)
+	if len(apis) == 0 {
+		return nil, errors.New("empty endpoint list")
+	}
+	for i := range apis {
+		pool.nodes = append(pool.nodes, &poolNode{
+			api:     apis[i],
+			healthy: true,
+		})
+	}
+	return pool, nil
+}
+
+func newPool(opts ...PoolOption) *Pool {
+	pool := &Pool{
+		maxLag:        DefaultPoolMaxLag,
+		checkInterval: DefaultPoolCheckInterval,
+	}
+	for i := range opts {
+		opts[i](pool)
+	}
+	return pool
+}
+
+// Start - runs health checks immediately and then periodically until context is done
+func (p *Pool) Start(ctx context.Context) {
+	if !p.started.CompareAndSwap(false, true) {
+		return
+	}
+	p.Check(ctx)
+
+	p.wg.Add(1)
+	go p.checking(ctx)
+}
+
+// Close -
+func (p *Pool) Close() error {
+	p.wg.Wait()
+	return nil
+}
+
+func (p *Pool) checking(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Check(ctx)
+		}
+	}
+}
+
+// Check - requests bootstrap status and head level of every node
+func (p *Pool) Check(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, node := range p.nodes {
+		wg.Add(1)
+		go func(node *poolNode) {
+			defer wg.Done()
+			p.check(ctx, node)
+		}(node)
+	}
+	wg.Wait()
+}
+
+func (p *Pool) check(ctx context.Context, node *poolNode) {
+	ctx, cancel := context.WithTimeout(ctx, p.checkInterval)
+	defer cancel()
+
+	bootstrapped, err := node.api.IsBootstrapped(ctx)
+	if err != nil {
+		log.Warn().Err(err).Str("url", node.api.URL()).Msg("node health check")
+		node.setFailed(err)
+		return
+	}
+	header, err := node.api.Header(ctx, "head")
+	if err != nil {
+		log.Warn().Err(err).Str("url", node.api.URL()).Msg("node health check")
+		node.setFailed(err)
+		return
+	}
+
+	node.mx.Lock()
+	node.healthy = bootstrapped.Bootstrapped
+	node.bootstrapped = bootstrapped.Bootstrapped
+	node.level = header.Level
+	node.err = nil
+	node.mx.Unlock()
+}
+
+// Status - returns health state of pool endpoints
+func (p *Pool) Status() []NodeStatus {
+	status := make([]NodeStatus, len(p.nodes))
+	for i, node := range p.nodes {
+		node.mx.RLock()
+		status[i] = NodeStatus{
+			URL:          node.api.URL(),
+			Healthy:      node.healthy,
+			Bootstrapped: node.bootstrapped,
+			Level:        node.level,
+			Err:          node.err,
+		}
+		node.mx.RUnlock()
+	}
+	return status
+}
+
+// candidates - returns nodes in order of request attempts: healthy and synced nodes in round-robin order first,
+// then the other ones as last resort
+func (p *Pool) candidates() []*poolNode {
+	var maxLevel uint64
+	for _, node := range p.nodes {
+		node.mx.RLock()
+		if node.healthy && node.level > maxLevel {
+			maxLevel = node.level
+		}
+		node.mx.RUnlock()
+	}
+
+	start := int(p.next.Add(1) % uint64(len(p.nodes)))
+	preferred := make([]*poolNode, 0, len(p.nodes))
+	reserve := make([]*poolNode, 0)
+	for i := range p.nodes {
+		node := p.nodes[(start+i)%len(p.nodes)]
+		node.mx.RLock()
+		synced := node.healthy && node.level+p.maxLag >= maxLevel
+		node.mx.RUnlock()
+
+		if synced {
+			preferred = append(preferred, node)
+		} else {
+			reserve = append(reserve, node)
+		}
+	}
+	return append(preferred, reserve...)
+}
+
+func poolCall[T any](ctx context.Context, p *Pool, call func(api API) (T, error)) (T, error) {
+	var (
+		result T
+		errs   []error
+	)
+	for _, node := range p.candidates() {
+		value, err := call(node.api)
+		if err == nil {
+			return value, nil
+		}
+		if !isFailoverError(ctx, err) {
+			return value, err
+		}
+		node.setFailed(err)
+		errs = append(errs, errors.Wrap(err, node.api.URL()))
+	}
+	return result, PoolError{Errors: errs}
+}
+
+// isFailoverError - returns true if request may succeed on another node. Client errors like 404, protocol errors in the body of 500 response,
+// decoding errors and cancellation of the request are returned as is. Timeout of HTTP client is the node's failure, so it's repeated on another node.
+func isFailoverError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		var urlErr *url.Error
+		return errors.As(err, &urlErr) && urlErr.Timeout()
+	}
+	var reqErr RequestError
+	if errors.As(err, &reqErr) {
+		if reqErr.ProtocolErrors() != nil {
+			return false
+		}
+		return reqErr.Code >= http.StatusInternalServerError || reqErr.Code == http.StatusTooManyRequests
+	}
+	var decodeErr DecodeError
+	return !errors.As(err, &decodeErr)
+}
@@ -0,0 +1,795 @@
+package node
+
+import (
+	"context"
+	stdJSON "encoding/json"
+)
+
+// Blocks -
+func (p *Pool) Blocks(ctx context.Context, args BlocksArgs) ([][]string, error) {
+	return poolCall(ctx, p, func(api API) ([][]string, error) {
+		return api.Blocks(ctx, args)
+	})
+}
+
+// Block -
+func (p *Pool) Block(ctx context.Context, blockID string) (Block, error) {
+	return poolCall(ctx, p, func(api API) (Block, error) {
+		return api.Block(ctx, blockID)
+	})
+}
+
+// Head -
+func (p *Pool) Head(ctx context.Context) (Block, error) {
+	return poolCall(ctx, p, func(api API) (Block, error) {
+		return api.Head(ctx)
+	})
+}
+
+// Header -
+func (p *Pool) Header(ctx context.Context, blockID string) (Header, error) {
+	return poolCall(ctx, p, func(api API) (Header, error) {
+		return api.Header(ctx, blockID)
+	})
+}
+
+// HeaderRaw -
+func (p *Pool) HeaderRaw(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.HeaderRaw(ctx, blockID)
+	})
+}
+
+// HeaderShell -
+func (p *Pool) HeaderShell(ctx context.Context, blockID string) (HeaderShell, error) {
+	return poolCall(ctx, p, func(api API) (HeaderShell, error) {
+		return api.HeaderShell(ctx, blockID)
+	})
+}
+
+// Metadata -
+func (p *Pool) Metadata(ctx context.Context, blockID string) (BlockMetadata, error) {
+	return poolCall(ctx, p, func(api API) (BlockMetadata, error) {
+		return api.Metadata(ctx, blockID)
+	})
+}
+
+// MetadataHash -
+func (p *Pool) MetadataHash(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.MetadataHash(ctx, blockID)
+	})
+}
+
+// Hash -
+func (p *Pool) Hash(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.Hash(ctx, blockID)
+	})
+}
+
+// ProtocolData -
+func (p *Pool) ProtocolData(ctx context.Context, blockID string) (ProtocolData, error) {
+	return poolCall(ctx, p, func(api API) (ProtocolData, error) {
+		return api.ProtocolData(ctx, blockID)
+	})
+}
+
+// ProtocolDataRaw -
+func (p *Pool) ProtocolDataRaw(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ProtocolDataRaw(ctx, blockID)
+	})
+}
+
+// OperationHashes -
+func (p *Pool) OperationHashes(ctx context.Context, blockID string) ([][]string, error) {
+	return poolCall(ctx, p, func(api API) ([][]string, error) {
+		return api.OperationHashes(ctx, blockID)
+	})
+}
+
+// OperationMetadataHash -
+func (p *Pool) OperationMetadataHash(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.OperationMetadataHash(ctx, blockID)
+	})
+}
+
+// OperationMetadataHashes -
+func (p *Pool) OperationMetadataHashes(ctx context.Context, blockID string) ([][]string, error) {
+	return poolCall(ctx, p, func(api API) ([][]string, error) {
+		return api.OperationMetadataHashes(ctx, blockID)
+	})
+}
+
+// Operations -
+func (p *Pool) Operations(ctx context.Context, blockID string) ([][]OperationGroup, error) {
+	return poolCall(ctx, p, func(api API) ([][]OperationGroup, error) {
+		return api.Operations(ctx, blockID)
+	})
+}
+
+// OperationsOffset -
+func (p *Pool) OperationsOffset(ctx context.Context, blockID string, listOffset int) ([]OperationGroup, error) {
+	return poolCall(ctx, p, func(api API) ([]OperationGroup, error) {
+		return api.OperationsOffset(ctx, blockID, listOffset)
+	})
+}
+
+// Operation -
+func (p *Pool) Operation(ctx context.Context, blockID string, listOffset, operationOffset int) (OperationGroup, error) {
+	return poolCall(ctx, p, func(api API) (OperationGroup, error) {
+		return api.Operation(ctx, blockID, listOffset, operationOffset)
+	})
+}
+
+// BlockProtocols -
+func (p *Pool) BlockProtocols(ctx context.Context, blockID string) (BlockProtocols, error) {
+	return poolCall(ctx, p, func(api API) (BlockProtocols, error) {
+		return api.BlockProtocols(ctx, blockID)
+	})
+}
+
+// VotesBallotList -
+func (p *Pool) VotesBallotList(ctx context.Context, blockID string) ([]BlockBallot, error) {
+	return poolCall(ctx, p, func(api API) ([]BlockBallot, error) {
+		return api.VotesBallotList(ctx, blockID)
+	})
+}
+
+// VotesBallots -
+func (p *Pool) VotesBallots(ctx context.Context, blockID string) (BlockBallots, error) {
+	return poolCall(ctx, p, func(api API) (BlockBallots, error) {
+		return api.VotesBallots(ctx, blockID)
+	})
+}
+
+// VotesCurrentPeriod -
+func (p *Pool) VotesCurrentPeriod(ctx context.Context, blockID string) (VotingPeriod, error) {
+	return poolCall(ctx, p, func(api API) (VotingPeriod, error) {
+		return api.VotesCurrentPeriod(ctx, blockID)
+	})
+}
+
+// VotesCurrentProposal -
+func (p *Pool) VotesCurrentProposal(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.VotesCurrentProposal(ctx, blockID)
+	})
+}
+
+// VotesQuorum -
+func (p *Pool) VotesQuorum(ctx context.Context, blockID string) (int, error) {
+	return poolCall(ctx, p, func(api API) (int, error) {
+		return api.VotesQuorum(ctx, blockID)
+	})
+}
+
+// VotesListing -
+func (p *Pool) VotesListing(ctx context.Context, blockID string) ([]Rolls, error) {
+	return poolCall(ctx, p, func(api API) ([]Rolls, error) {
+		return api.VotesListing(ctx, blockID)
+	})
+}
+
+// VotesProposals -
+func (p *Pool) VotesProposals(ctx context.Context, blockID string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.VotesProposals(ctx, blockID)
+	})
+}
+
+// VotesSuccessorPeriod -
+func (p *Pool) VotesSuccessorPeriod(ctx context.Context, blockID string) (VotingPeriod, error) {
+	return poolCall(ctx, p, func(api API) (VotingPeriod, error) {
+		return api.VotesSuccessorPeriod(ctx, blockID)
+	})
+}
+
+// VotesTotalVotingPower -
+func (p *Pool) VotesTotalVotingPower(ctx context.Context, blockID string) (int, error) {
+	return poolCall(ctx, p, func(api API) (int, error) {
+		return api.VotesTotalVotingPower(ctx, blockID)
+	})
+}
+
+// ChainID -
+func (p *Pool) ChainID(ctx context.Context) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ChainID(ctx)
+	})
+}
+
+// InvalidBlocks -
+func (p *Pool) InvalidBlocks(ctx context.Context) ([]InvalidBlock, error) {
+	return poolCall(ctx, p, func(api API) ([]InvalidBlock, error) {
+		return api.InvalidBlocks(ctx)
+	})
+}
+
+// InvalidBlock -
+func (p *Pool) InvalidBlock(ctx context.Context, blockHash string) (InvalidBlock, error) {
+	return poolCall(ctx, p, func(api API) (InvalidBlock, error) {
+		return api.InvalidBlock(ctx, blockHash)
+	})
+}
+
+// IsBootstrapped -
+func (p *Pool) IsBootstrapped(ctx context.Context) (Bootstrapped, error) {
+	return poolCall(ctx, p, func(api API) (Bootstrapped, error) {
+		return api.IsBootstrapped(ctx)
+	})
+}
+
+// LevelsCaboose -
+func (p *Pool) LevelsCaboose(ctx context.Context) (Caboose, error) {
+	return poolCall(ctx, p, func(api API) (Caboose, error) {
+		return api.LevelsCaboose(ctx)
+	})
+}
+
+// LevelsCheckpoint -
+func (p *Pool) LevelsCheckpoint(ctx context.Context) (Checkpoint, error) {
+	return poolCall(ctx, p, func(api API) (Checkpoint, error) {
+		return api.LevelsCheckpoint(ctx)
+	})
+}
+
+// LevelsSavepoint -
+func (p *Pool) LevelsSavepoint(ctx context.Context) (Savepoint, error) {
+	return poolCall(ctx, p, func(api API) (Savepoint, error) {
+		return api.LevelsSavepoint(ctx)
+	})
+}
+
+// PendingOperations -
+func (p *Pool) PendingOperations(ctx context.Context) (MempoolResponse, error) {
+	return poolCall(ctx, p, func(api API) (MempoolResponse, error) {
+		return api.PendingOperations(ctx)
+	})
+}
+
+// BigMap -
+func (p *Pool) BigMap(ctx context.Context, blockID string, bigMapID uint64) ([]byte, error) {
+	return poolCall(ctx, p, func(api API) ([]byte, error) {
+		return api.BigMap(ctx, blockID, bigMapID)
+	})
+}
+
+// BigMapKey -
+func (p *Pool) BigMapKey(ctx context.Context, blockID string, bigMapID uint64, key string) (interface{}, error) {
+	return poolCall(ctx, p, func(api API) (interface{}, error) {
+		return api.BigMapKey(ctx, blockID, bigMapID, key)
+	})
+}
+
+// CacheContracts -
+func (p *Pool) CacheContracts(ctx context.Context, blockID string) (interface{}, error) {
+	return poolCall(ctx, p, func(api API) (interface{}, error) {
+		return api.CacheContracts(ctx, blockID)
+	})
+}
+
+// CacheContractsSize -
+func (p *Pool) CacheContractsSize(ctx context.Context, blockID string) (uint64, error) {
+	return poolCall(ctx, p, func(api API) (uint64, error) {
+		return api.CacheContractsSize(ctx, blockID)
+	})
+}
+
+// CacheContractsSizeLimit -
+func (p *Pool) CacheContractsSizeLimit(ctx context.Context, blockID string) (uint64, error) {
+	return poolCall(ctx, p, func(api API) (uint64, error) {
+		return api.CacheContractsSizeLimit(ctx, blockID)
+	})
+}
+
+// Constants -
+func (p *Pool) Constants(ctx context.Context, blockID string) (Constants, error) {
+	return poolCall(ctx, p, func(api API) (Constants, error) {
+		return api.Constants(ctx, blockID)
+	})
+}
+
+// Contracts -
+func (p *Pool) Contracts(ctx context.Context, blockID string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.Contracts(ctx, blockID)
+	})
+}
+
+// Contract -
+func (p *Pool) Contract(ctx context.Context, blockID, contract string) (ContractInfo, error) {
+	return poolCall(ctx, p, func(api API) (ContractInfo, error) {
+		return api.Contract(ctx, blockID, contract)
+	})
+}
+
+// ContractBalance -
+func (p *Pool) ContractBalance(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractBalance(ctx, blockID, contract)
+	})
+}
+
+// ContractCounter -
+func (p *Pool) ContractCounter(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractCounter(ctx, blockID, contract)
+	})
+}
+
+// ContractDelegate -
+func (p *Pool) ContractDelegate(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractDelegate(ctx, blockID, contract)
+	})
+}
+
+// ContractManagerKey -
+func (p *Pool) ContractManagerKey(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractManagerKey(ctx, blockID, contract)
+	})
+}
+
+// ContractEntrypoints -
+func (p *Pool) ContractEntrypoints(ctx context.Context, blockID, contract string) (Entrypoints, error) {
+	return poolCall(ctx, p, func(api API) (Entrypoints, error) {
+		return api.ContractEntrypoints(ctx, blockID, contract)
+	})
+}
+
+// ContractEntrypoint -
+func (p *Pool) ContractEntrypoint(ctx context.Context, blockID, contract, entrypoint string) (stdJSON.RawMessage, error) {
+	return poolCall(ctx, p, func(api API) (stdJSON.RawMessage, error) {
+		return api.ContractEntrypoint(ctx, blockID, contract, entrypoint)
+	})
+}
+
+// ContractScript -
+func (p *Pool) ContractScript(ctx context.Context, blockID, contract string) (Script, error) {
+	return poolCall(ctx, p, func(api API) (Script, error) {
+		return api.ContractScript(ctx, blockID, contract)
+	})
+}
+
+// ContractStorage -
+func (p *Pool) ContractStorage(ctx context.Context, blockID, contract string) (stdJSON.RawMessage, error) {
+	return poolCall(ctx, p, func(api API) (stdJSON.RawMessage, error) {
+		return api.ContractStorage(ctx, blockID, contract)
+	})
+}
+
+// Delegates -
+func (p *Pool) Delegates(ctx context.Context, blockID string, active DelegateType) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.Delegates(ctx, blockID, active)
+	})
+}
+
+// Delegate -
+func (p *Pool) Delegate(ctx context.Context, blockID, pkh string) (Delegate, error) {
+	return poolCall(ctx, p, func(api API) (Delegate, error) {
+		return api.Delegate(ctx, blockID, pkh)
+	})
+}
+
+// DelegateDeactivated -
+func (p *Pool) DelegateDeactivated(ctx context.Context, blockID, pkh string) (bool, error) {
+	return poolCall(ctx, p, func(api API) (bool, error) {
+		return api.DelegateDeactivated(ctx, blockID, pkh)
+	})
+}
+
+// DelegateBalance -
+func (p *Pool) DelegateBalance(ctx context.Context, blockID, pkh string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.DelegateBalance(ctx, blockID, pkh)
+	})
+}
+
+// DelegateContracts -
+func (p *Pool) DelegateContracts(ctx context.Context, blockID, pkh string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.DelegateContracts(ctx, blockID, pkh)
+	})
+}
+
+// DelegateGracePeriod -
+func (p *Pool) DelegateGracePeriod(ctx context.Context, blockID, pkh string) (int, error) {
+	return poolCall(ctx, p, func(api API) (int, error) {
+		return api.DelegateGracePeriod(ctx, blockID, pkh)
+	})
+}
+
+// DelegateStakingBalance -
+func (p *Pool) DelegateStakingBalance(ctx context.Context, blockID, pkh string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.DelegateStakingBalance(ctx, blockID, pkh)
+	})
+}
+
+// DelegateVotingPower -
+func (p *Pool) DelegateVotingPower(ctx context.Context, blockID, pkh string) (int, error) {
+	return poolCall(ctx, p, func(api API) (int, error) {
+		return api.DelegateVotingPower(ctx, blockID, pkh)
+	})
+}
+
+// ActiveDelegatesWithRolls -
+func (p *Pool) ActiveDelegatesWithRolls(ctx context.Context, blockID string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.ActiveDelegatesWithRolls(ctx, blockID)
+	})
+}
+
+// LiquidityBakingCPMMAddress -
+func (p *Pool) LiquidityBakingCPMMAddress(ctx context.Context, blockID string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.LiquidityBakingCPMMAddress(ctx, blockID)
+	})
+}
+
+// TxRollupState -
+func (p *Pool) TxRollupState(ctx context.Context, blockID, txRollupID string) (TxRollupState, error) {
+	return poolCall(ctx, p, func(api API) (TxRollupState, error) {
+		return api.TxRollupState(ctx, blockID, txRollupID)
+	})
+}
+
+// TxRollupCommitment -
+func (p *Pool) TxRollupCommitment(ctx context.Context, blockID, txRollupID, blockLevel string) (*RollupCommitmentForBlock, error) {
+	return poolCall(ctx, p, func(api API) (*RollupCommitmentForBlock, error) {
+		return api.TxRollupCommitment(ctx, blockID, txRollupID, blockLevel)
+	})
+}
+
+// TxRollupInbox -
+func (p *Pool) TxRollupInbox(ctx context.Context, blockID, txRollupID, blockLevel string) (*TxRollupInbox, error) {
+	return poolCall(ctx, p, func(api API) (*TxRollupInbox, error) {
+		return api.TxRollupInbox(ctx, blockID, txRollupID, blockLevel)
+	})
+}
+
+// TxRollupPendingBondedCommitments -
+func (p *Pool) TxRollupPendingBondedCommitments(ctx context.Context, blockID, txRollupID, pkh string) (uint64, error) {
+	return poolCall(ctx, p, func(api API) (uint64, error) {
+		return api.TxRollupPendingBondedCommitments(ctx, blockID, txRollupID, pkh)
+	})
+}
+
+// DelegateStakers -
+func (p *Pool) DelegateStakers(ctx context.Context, blockID, pkh string) ([]DelegateStaker, error) {
+	return poolCall(ctx, p, func(api API) ([]DelegateStaker, error) {
+		return api.DelegateStakers(ctx, blockID, pkh)
+	})
+}
+
+// DelegateActiveStakingParameters -
+func (p *Pool) DelegateActiveStakingParameters(ctx context.Context, blockID, pkh string) (StakingParameters, error) {
+	return poolCall(ctx, p, func(api API) (StakingParameters, error) {
+		return api.DelegateActiveStakingParameters(ctx, blockID, pkh)
+	})
+}
+
+// DelegatePendingStakingParameters -
+func (p *Pool) DelegatePendingStakingParameters(ctx context.Context, blockID, pkh string) ([]PendingStakingParameters, error) {
+	return poolCall(ctx, p, func(api API) ([]PendingStakingParameters, error) {
+		return api.DelegatePendingStakingParameters(ctx, blockID, pkh)
+	})
+}
+
+// DelegateFullBalance -
+func (p *Pool) DelegateFullBalance(ctx context.Context, blockID, pkh string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.DelegateFullBalance(ctx, blockID, pkh)
+	})
+}
+
+// DelegateConsensusKey -
+func (p *Pool) DelegateConsensusKey(ctx context.Context, blockID, pkh string) (ConsensusKeys, error) {
+	return poolCall(ctx, p, func(api API) (ConsensusKeys, error) {
+		return api.DelegateConsensusKey(ctx, blockID, pkh)
+	})
+}
+
+// ContractFullBalance -
+func (p *Pool) ContractFullBalance(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractFullBalance(ctx, blockID, contract)
+	})
+}
+
+// ContractStakedBalance -
+func (p *Pool) ContractStakedBalance(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractStakedBalance(ctx, blockID, contract)
+	})
+}
+
+// ContractUnstakedFrozenBalance -
+func (p *Pool) ContractUnstakedFrozenBalance(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractUnstakedFrozenBalance(ctx, blockID, contract)
+	})
+}
+
+// ContractUnstakedFinalizableBalance -
+func (p *Pool) ContractUnstakedFinalizableBalance(ctx context.Context, blockID, contract string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractUnstakedFinalizableBalance(ctx, blockID, contract)
+	})
+}
+
+// ContractUnstakeRequests -
+func (p *Pool) ContractUnstakeRequests(ctx context.Context, blockID, contract string) (*UnstakeRequests, error) {
+	return poolCall(ctx, p, func(api API) (*UnstakeRequests, error) {
+		return api.ContractUnstakeRequests(ctx, blockID, contract)
+	})
+}
+
+// ContractAllTicketBalances -
+func (p *Pool) ContractAllTicketBalances(ctx context.Context, blockID, contract string) ([]TicketBalance, error) {
+	return poolCall(ctx, p, func(api API) ([]TicketBalance, error) {
+		return api.ContractAllTicketBalances(ctx, blockID, contract)
+	})
+}
+
+// ContractTicketBalance -
+func (p *Pool) ContractTicketBalance(ctx context.Context, blockID, contract string, ticket Ticket) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.ContractTicketBalance(ctx, blockID, contract, ticket)
+	})
+}
+
+// SmartRollups -
+func (p *Pool) SmartRollups(ctx context.Context, blockID string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.SmartRollups(ctx, blockID)
+	})
+}
+
+// SmartRollupInbox -
+func (p *Pool) SmartRollupInbox(ctx context.Context, blockID string) (SmartRollupInbox, error) {
+	return poolCall(ctx, p, func(api API) (SmartRollupInbox, error) {
+		return api.SmartRollupInbox(ctx, blockID)
+	})
+}
+
+// SmartRollupKind -
+func (p *Pool) SmartRollupKind(ctx context.Context, blockID, address string) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.SmartRollupKind(ctx, blockID, address)
+	})
+}
+
+// SmartRollupGenesisInfo -
+func (p *Pool) SmartRollupGenesisInfo(ctx context.Context, blockID, address string) (SmartRollupGenesisInfo, error) {
+	return poolCall(ctx, p, func(api API) (SmartRollupGenesisInfo, error) {
+		return api.SmartRollupGenesisInfo(ctx, blockID, address)
+	})
+}
+
+// SmartRollupLastCementedCommitment -
+func (p *Pool) SmartRollupLastCementedCommitment(ctx context.Context, blockID, address string) (SmartRollupCementedCommitment, error) {
+	return poolCall(ctx, p, func(api API) (SmartRollupCementedCommitment, error) {
+		return api.SmartRollupLastCementedCommitment(ctx, blockID, address)
+	})
+}
+
+// SmartRollupCommitment -
+func (p *Pool) SmartRollupCommitment(ctx context.Context, blockID, address, hash string) (SrCommitmentInfo, error) {
+	return poolCall(ctx, p, func(api API) (SrCommitmentInfo, error) {
+		return api.SmartRollupCommitment(ctx, blockID, address, hash)
+	})
+}
+
+// SmartRollupStakedOnCommitment -
+func (p *Pool) SmartRollupStakedOnCommitment(ctx context.Context, blockID, address, staker string) (*SmartRollupStakedCommitment, error) {
+	return poolCall(ctx, p, func(api API) (*SmartRollupStakedCommitment, error) {
+		return api.SmartRollupStakedOnCommitment(ctx, blockID, address, staker)
+	})
+}
+
+// SmartRollupWhitelist -
+func (p *Pool) SmartRollupWhitelist(ctx context.Context, blockID, address string) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.SmartRollupWhitelist(ctx, blockID, address)
+	})
+}
+
+// DalParameters -
+func (p *Pool) DalParameters(ctx context.Context, blockID string) (DalParametric, error) {
+	return poolCall(ctx, p, func(api API) (DalParametric, error) {
+		return api.DalParameters(ctx, blockID)
+	})
+}
+
+// DalShards -
+func (p *Pool) DalShards(ctx context.Context, blockID string, delegates ...string) ([]DalShards, error) {
+	return poolCall(ctx, p, func(api API) ([]DalShards, error) {
+		return api.DalShards(ctx, blockID, delegates...)
+	})
+}
+
+// HistoryMode -
+func (p *Pool) HistoryMode(ctx context.Context) (HistoryMode, error) {
+	return poolCall(ctx, p, func(api API) (HistoryMode, error) {
+		return api.HistoryMode(ctx)
+	})
+}
+
+// UserActivatedProtocols -
+func (p *Pool) UserActivatedProtocols(ctx context.Context) ([]ActivatedProtocol, error) {
+	return poolCall(ctx, p, func(api API) ([]ActivatedProtocol, error) {
+		return api.UserActivatedProtocols(ctx)
+	})
+}
+
+// UserActivatedUpgrades -
+func (p *Pool) UserActivatedUpgrades(ctx context.Context) ([]ActivatedUpgrades, error) {
+	return poolCall(ctx, p, func(api API) ([]ActivatedUpgrades, error) {
+		return api.UserActivatedUpgrades(ctx)
+	})
+}
+
+// Version -
+func (p *Pool) Version(ctx context.Context) (Version, error) {
+	return poolCall(ctx, p, func(api API) (Version, error) {
+		return api.Version(ctx)
+	})
+}
+
+// StatsGC -
+func (p *Pool) StatsGC(ctx context.Context) (StatsGC, error) {
+	return poolCall(ctx, p, func(api API) (StatsGC, error) {
+		return api.StatsGC(ctx)
+	})
+}
+
+// StatsMemory -
+func (p *Pool) StatsMemory(ctx context.Context) (StatsMemory, error) {
+	return poolCall(ctx, p, func(api API) (StatsMemory, error) {
+		return api.StatsMemory(ctx)
+	})
+}
+
+// GetProtocols -
+func (p *Pool) GetProtocols(ctx context.Context) ([]string, error) {
+	return poolCall(ctx, p, func(api API) ([]string, error) {
+		return api.GetProtocols(ctx)
+	})
+}
+
+// Protocol -
+func (p *Pool) Protocol(ctx context.Context, hash string) (ProtocolInfo, error) {
+	return poolCall(ctx, p, func(api API) (ProtocolInfo, error) {
+		return api.Protocol(ctx, hash)
+	})
+}
+
+// Environment -
+func (p *Pool) Environment(ctx context.Context, hash string) (int, error) {
+	return poolCall(ctx, p, func(api API) (int, error) {
+		return api.Environment(ctx, hash)
+	})
+}
+
+// Connections -
+func (p *Pool) Connections(ctx context.Context) ([]Connection, error) {
+	return poolCall(ctx, p, func(api API) ([]Connection, error) {
+		return api.Connections(ctx)
+	})
+}
+
+// Connection -
+func (p *Pool) Connection(ctx context.Context, peerID string) (Connection, error) {
+	return poolCall(ctx, p, func(api API) (Connection, error) {
+		return api.Connection(ctx, peerID)
+	})
+}
+
+// Points -
+func (p *Pool) Points(ctx context.Context) ([]NetworkPointWithURI, error) {
+	return poolCall(ctx, p, func(api API) ([]NetworkPointWithURI, error) {
+		return api.Points(ctx)
+	})
+}
+
+// ConnectionVersion -
+func (p *Pool) ConnectionVersion(ctx context.Context) (ConnectionVersion, error) {
+	return poolCall(ctx, p, func(api API) (ConnectionVersion, error) {
+		return api.ConnectionVersion(ctx)
+	})
+}
+
+// InjectOperation -
+func (p *Pool) InjectOperation(ctx context.Context, request InjectOperationRequest) (string, error) {
+	return poolCall(ctx, p, func(api API) (string, error) {
+		return api.InjectOperation(ctx, request)
+	})
+}
+
+// RunOperation -
+func (p *Pool) RunOperation(ctx context.Context, blockID string, request RunOperationRequest) (RunOperationResponse, error) {
+	return poolCall(ctx, p, func(api API) (RunOperationResponse, error) {
+		return api.RunOperation(ctx, blockID, request)
+	})
+}
+
+// SimulateOperation -
+func (p *Pool) SimulateOperation(ctx context.Context, blockID string, request SimulateOperationRequest) (RunOperationResponse, error) {
+	return poolCall(ctx, p, func(api API) (RunOperationResponse, error) {
+		return api.SimulateOperation(ctx, blockID, request)
+	})
+}
+
+// PreapplyOperations -
+func (p *Pool) PreapplyOperations(ctx context.Context, blockID string, operations []PreapplyOperation) ([]RunOperationResponse, error) {
+	return poolCall(ctx, p, func(api API) ([]RunOperationResponse, error) {
+		return api.PreapplyOperations(ctx, blockID, operations)
+	})
+}
+
+// RunCode -
+func (p *Pool) RunCode(ctx context.Context, blockID string, request RunCodeRequest) (RunCodeResponse, error) {
+	return poolCall(ctx, p, func(api API) (RunCodeResponse, error) {
+		return api.RunCode(ctx, blockID, request)
+	})
+}
+
+// TraceCode -
+func (p *Pool) TraceCode(ctx context.Context, blockID string, request RunCodeRequest) (TraceCodeResponse, error) {
+	return poolCall(ctx, p, func(api API) (TraceCodeResponse, error) {
+		return api.TraceCode(ctx, blockID, request)
+	})
+}
+
+// RunView -
+func (p *Pool) RunView(ctx context.Context, blockID string, request RunViewRequest) (RunViewResponse, error) {
+	return poolCall(ctx, p, func(api API) (RunViewResponse, error) {
+		return api.RunView(ctx, blockID, request)
+	})
+}
+
+// RunScriptView -
+func (p *Pool) RunScriptView(ctx context.Context, blockID string, request RunScriptViewRequest) (RunViewResponse, error) {
+	return poolCall(ctx, p, func(api API) (RunViewResponse, error) {
+		return api.RunScriptView(ctx, blockID, request)
+	})
+}
+
+// TypecheckCode -
+func (p *Pool) TypecheckCode(ctx context.Context, blockID string, request TypecheckCodeRequest) (TypecheckCodeResponse, error) {
+	return poolCall(ctx, p, func(api API) (TypecheckCodeResponse, error) {
+		return api.TypecheckCode(ctx, blockID, request)
+	})
+}
+
+// TypecheckData -
+func (p *Pool) TypecheckData(ctx context.Context, blockID string, request TypecheckDataRequest) (TypecheckDataResponse, error) {
+	return poolCall(ctx, p, func(api API) (TypecheckDataResponse, error) {
+		return api.TypecheckData(ctx, blockID, request)
+	})
+}
+
+// PackData -
+func (p *Pool) PackData(ctx context.Context, blockID string, request PackDataRequest) (PackDataResponse, error) {
+	return poolCall(ctx, p, func(api API) (PackDataResponse, error) {
+		return api.PackData(ctx, blockID, request)
+	})
+}
+
+// NormalizeData -
+func (p *Pool) NormalizeData(ctx context.Context, blockID string, request NormalizeDataRequest) (NormalizeDataResponse, error) {
+	return poolCall(ctx, p, func(api API) (NormalizeDataResponse, error) {
+		return api.NormalizeData(ctx, blockID, request)
+	})
+}
+
+// URL - returns URL of the node which receives requests first
+func (p *Pool) URL() string {
+	return p.candidates()[0].api.URL()
+}
@@ -0,0 +1,341 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode - fake node which returns its name as block hash
+type testNode struct {
+	*httptest.Server
+
+	name    string
+	level   atomic.Uint64
+	status  atomic.Int32
+	body    atomic.Pointer[string]
+	headers atomic.Int32
+}
+
+func newTestNode(t *testing.T, name string, level uint64) *testNode {
+	node := &testNode{name: name}
+	node.level.Store(level)
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status := node.status.Load(); status != 0 {
+			node.headers.Add(1)
+			w.WriteHeader(int(status))
+			if body := node.body.Load(); body != nil {
+				_, _ = w.Write([]byte(*body))
+			}
+			return
+		}
+		switch r.URL.Path {
+		case "/chains/main/is_bootstrapped":
+			_, _ = w.Write([]byte(`{"bootstrapped":true,"sync_state":"synced"}`))
+		case "/chains/main/blocks/head/header":
+			node.headers.Add(1)
+			_, _ = fmt.Fprintf(w, `{"level":%d,"hash":%q}`, node.level.Load(), node.name)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func newTestPool(t *testing.T, nodes ...*testNode) *Pool {
+	urls := make([]string, len(nodes))
+	for i := range nodes {
+		urls[i] = nodes[i].URL
+	}
+	pool, err := NewMainPool(urls, WithMaxLag(2))
+	require.NoError(t, err)
+	return pool
+}
+
+func TestPool_FailingNode(t *testing.T) {
+	failing := newTestNode(t, "failing", 100)
+	failing.status.Store(http.StatusInternalServerError)
+	alive := newTestNode(t, "alive", 100)
+
+	pool := newTestPool(t, failing, alive)
+	for range 4 {
+		header, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+		require.Equal(t, "alive", header.Hash)
+	}
+
+	status := pool.Status()
+	require.False(t, status[0].Healthy)
+	require.Error(t, status[0].Err)
+	require.True(t, status[1].Healthy)
+}
+
+func TestPool_LaggingNode(t *testing.T) {
+	synced := newTestNode(t, "synced", 100)
+	lagging := newTestNode(t, "lagging", 90)
+
+	pool := newTestPool(t, synced, lagging)
+	pool.Check(t.Context())
+	synced.headers.Store(0)
+	lagging.headers.Store(0)
+
+	for range 4 {
+		header, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+		require.Equal(t, "synced", header.Hash)
+	}
+	require.EqualValues(t, 4, synced.headers.Load())
+	require.EqualValues(t, 0, lagging.headers.Load())
+
+	// lagging node is the last resort
+	synced.status.Store(http.StatusBadGateway)
+	header, err := pool.Header(t.Context(), HeadBlock)
+	require.NoError(t, err)
+	require.Equal(t, "lagging", header.Hash)
+}
+
+func TestPool_RecoveryByCheck(t *testing.T) {
+	first := newTestNode(t, "first", 100)
+	second := newTestNode(t, "second", 100)
+	first.status.Store(http.StatusServiceUnavailable)
+
+	pool := newTestPool(t, first, second)
+	for range 2 {
+		_, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+	}
+	require.False(t, pool.Status()[0].Healthy)
+
+	// unhealthy node stays unhealthy until check
+	first.status.Store(0)
+	for range 2 {
+		header, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+		require.Equal(t, "second", header.Hash)
+	}
+
+	pool.Check(t.Context())
+	status := pool.Status()
+	require.True(t, status[0].Healthy)
+	require.NoError(t, status[0].Err)
+	require.EqualValues(t, 100, status[0].Level)
+
+	first.headers.Store(0)
+	for range 4 {
+		_, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 2, first.headers.Load())
+}
+
+func TestPool_PoolError(t *testing.T) {
+	first := newTestNode(t, "first", 100)
+	second := newTestNode(t, "second", 100)
+	first.status.Store(http.StatusInternalServerError)
+	second.status.Store(http.StatusTooManyRequests)
+
+	pool := newTestPool(t, first, second)
+	_, err := pool.Header(t.Context(), HeadBlock)
+	require.Error(t, err)
+
+	var poolErr PoolError
+	require.True(t, errors.As(err, &poolErr))
+	require.Len(t, poolErr.Errors, 2)
+
+	codes := make(map[int]struct{})
+	for i := range poolErr.Errors {
+		var reqErr RequestError
+		require.True(t, errors.As(poolErr.Errors[i], &reqErr))
+		codes[reqErr.Code] = struct{}{}
+	}
+	require.Equal(t, map[int]struct{}{
+		http.StatusInternalServerError: {},
+		http.StatusTooManyRequests:     {},
+	}, codes)
+	require.Contains(t, err.Error(), first.URL)
+	require.Contains(t, err.Error(), second.URL)
+}
+
+func TestPool_NoFailover(t *testing.T) {
+	first := newTestNode(t, "first", 100)
+	second := newTestNode(t, "second", 100)
+
+	t.Run("client error", func(t *testing.T) {
+		first.status.Store(http.StatusNotFound)
+		second.status.Store(http.StatusNotFound)
+		t.Cleanup(func() {
+			first.status.Store(0)
+			second.status.Store(0)
+		})
+
+		pool := newTestPool(t, first, second)
+		_, err := pool.Header(t.Context(), HeadBlock)
+		var reqErr RequestError
+		require.True(t, errors.As(err, &reqErr))
+		require.Equal(t, http.StatusNotFound, reqErr.Code)
+		for _, status := range pool.Status() {
+			require.True(t, status.Healthy)
+		}
+	})
+
+	t.Run("protocol error", func(t *testing.T) {
+		body := `[{"kind":"temporary","id":"proto.023-PtSeouLo.contract.counter_in_the_past","contract":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","expected":"11","found":"10"}]`
+		for _, node := range []*testNode{first, second} {
+			node.status.Store(http.StatusInternalServerError)
+			node.body.Store(&body)
+			node.headers.Store(0)
+		}
+		t.Cleanup(func() {
+			for _, node := range []*testNode{first, second} {
+				node.status.Store(0)
+				node.body.Store(nil)
+			}
+		})
+
+		pool := newTestPool(t, first, second)
+		_, err := pool.Header(t.Context(), HeadBlock)
+		var reqErr RequestError
+		require.True(t, errors.As(err, &reqErr))
+		require.False(t, errors.As(err, new(PoolError)))
+		protocolErrors := reqErr.ProtocolErrors()
+		require.Len(t, protocolErrors, 1)
+		require.Equal(t, "proto.023-PtSeouLo.contract.counter_in_the_past", protocolErrors[0].ID)
+		require.EqualValues(t, 1, first.headers.Load()+second.headers.Load())
+		for _, status := range pool.Status() {
+			require.True(t, status.Healthy)
+		}
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		body := `{"level":"not a number"}`
+		for _, node := range []*testNode{first, second} {
+			node.status.Store(http.StatusOK)
+			node.body.Store(&body)
+			node.headers.Store(0)
+		}
+		t.Cleanup(func() {
+			for _, node := range []*testNode{first, second} {
+				node.status.Store(0)
+				node.body.Store(nil)
+			}
+		})
+
+		pool := newTestPool(t, first, second)
+		_, err := pool.Header(t.Context(), HeadBlock)
+		var decodeErr DecodeError
+		require.True(t, errors.As(err, &decodeErr))
+		require.False(t, errors.As(err, new(PoolError)))
+		require.EqualValues(t, 1, first.headers.Load()+second.headers.Load())
+		for _, status := range pool.Status() {
+			require.True(t, status.Healthy)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		pool := newTestPool(t, first, second)
+		_, err := pool.Header(ctx, HeadBlock)
+		require.ErrorIs(t, err, context.Canceled)
+		for _, status := range pool.Status() {
+			require.True(t, status.Healthy)
+		}
+	})
+}
+
+func TestIsFailoverError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{
+			name: "server error",
+			ctx:  context.Background(),
+			err:  RequestError{Code: http.StatusInternalServerError},
+			want: true,
+		}, {
+			name: "too many requests",
+			ctx:  context.Background(),
+			err:  RequestError{Code: http.StatusTooManyRequests},
+			want: true,
+		}, {
+			name: "protocol error",
+			ctx:  context.Background(),
+			err:  RequestError{Code: http.StatusInternalServerError, Body: `[{"kind":"permanent","id":"proto.023-PtSeouLo.michelson_v1.script_rejected"}]`},
+		}, {
+			name: "server error with list in body",
+			ctx:  context.Background(),
+			err:  RequestError{Code: http.StatusInternalServerError, Body: `[{"message":"internal error"}]`},
+			want: true,
+		}, {
+			name: "decode error",
+			ctx:  context.Background(),
+			err:  errors.Wrap(DecodeError{Err: errors.New("invalid character")}, "header"),
+		}, {
+			name: "not found",
+			ctx:  context.Background(),
+			err:  RequestError{Code: http.StatusNotFound},
+		}, {
+			name: "connection error",
+			ctx:  context.Background(),
+			err:  &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")},
+			want: true,
+		}, {
+			name: "client timeout",
+			ctx:  context.Background(),
+			err:  &url.Error{Op: "Get", URL: "http://localhost", Err: context.DeadlineExceeded},
+			want: true,
+		}, {
+			name: "canceled",
+			ctx:  context.Background(),
+			err:  errors.Wrap(context.Canceled, "request"),
+		}, {
+			name: "deadline exceeded",
+			ctx:  context.Background(),
+			err:  errors.Wrap(context.DeadlineExceeded, "request"),
+		}, {
+			name: "context is done",
+			ctx:  canceled,
+			err:  RequestError{Code: http.StatusInternalServerError},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isFailoverError(tt.ctx, tt.err))
+		})
+	}
+}
+
+func TestPool_ClientTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer slow.Close()
+	fast := newTestNode(t, "fast", 100)
+
+	pool, err := NewMainPool([]string{slow.URL, fast.URL}, WithClientOptions(WithTimeout(50*time.Millisecond)))
+	require.NoError(t, err)
+	for range 2 {
+		header, err := pool.Header(t.Context(), HeadBlock)
+		require.NoError(t, err)
+		require.Equal(t, "fast", header.Hash)
+	}
+}
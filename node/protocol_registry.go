@@ -0,0 +1,169 @@
+package node
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// NormalizeKind - maps kinds of consensus operations renamed in Oxford to their previous names,
+// so historical and current operations are handled by the same code. Other kinds are returned as is.
+func NormalizeKind(kind string) string {
+	switch kind {
+	case KindAttestation:
+		return KindEndorsement
+	case KindAttestationWithDal:
+		return KindEndorsementWithDal
+	case KindPreattestation:
+		return KindPreendorsement
+	case KindDoubleAttestation:
+		return KindDoubleEndorsing
+	case KindDoublePreattestation:
+		return KindDoublePreendorsement
+	default:
+		return kind
+	}
+}
+
+// NormalizedKind - returns operation kind in terms of `NormalizeKind`. `Kind` field keeps the value received from node.
+func (op Operation) NormalizedKind() string {
+	return NormalizeKind(op.Kind)
+}
+
+// ProtocolVersion - protocol description with features which change shape of RPC responses
+type ProtocolVersion struct {
+	Hash   string
+	Number int
+	Name   string
+
+	// Tenderbake - consensus operations have slot, round and payload hash, preendorsements exist (since Ithaca)
+	Tenderbake bool
+	// TxRollups - transaction rollups operations are allowed (Jakarta - Lima)
+	TxRollups bool
+	// SmartRollups - smart rollups operations are allowed (since Mumbai)
+	SmartRollups bool
+	// Attestations - consensus operations are named attestations (since Oxford)
+	Attestations bool
+	// Staking - staking parameters and unstake requests are available (since Oxford)
+	Staking bool
+}
+
+// Kind - returns name of operation kind used by the protocol. It's the reverse of `NormalizeKind`.
+func (v ProtocolVersion) Kind(kind string) string {
+	kind = NormalizeKind(kind)
+	if !v.Attestations {
+		return kind
+	}
+	switch kind {
+	case KindEndorsement:
+		return KindAttestation
+	case KindEndorsementWithDal:
+		return KindAttestationWithDal
+	case KindPreendorsement:
+		return KindPreattestation
+	case KindDoubleEndorsing:
+		return KindDoubleAttestation
+	case KindDoublePreendorsement:
+		return KindDoublePreattestation
+	default:
+		return kind
+	}
+}
+
+// known protocols since Babylon. Protocols are matched by vanity prefix of hash, so different builds of the same protocol are resolved too.
+var knownProtocols = []struct {
+	prefix string
+	number int
+	name   string
+}{
+	{"PsBABY5H", 5, "babylon"},
+	{"PsBabyM1", 5, "babylon"},
+	{"PsCARTHA", 6, "carthage"},
+	{"PsDELPH1", 7, "delphi"},
+	{"PtEdoTez", 8, "edo"},
+	{"PtEdo2Zk", 8, "edo"},
+	{"PsFLoren", 9, "florence"},
+	{"PtGRANAD", 10, "granada"},
+	{"PtHangz2", 11, "hangzhou"},
+	{"Psithaca", 12, "ithaca"},
+	{"PtJakart", 13, "jakarta"},
+	{"PtKathma", 14, "kathmandu"},
+	{"PtLimaPt", 15, "lima"},
+	{"PtMumbai", 16, "mumbai"},
+	{"PtNairob", 17, "nairobi"},
+	{"Proxford", 18, "oxford"},
+	{"PtParisB", 19, "paris"},
+	{"PsParisC", 20, "paris"},
+	{"PsQuebec", 21, "quebec"},
+	{"PsRiotum", 22, "rio"},
+	{"PtSeouLo", 23, "seoul"},
+}
+
+func newProtocolVersion(hash string, number int, name string) ProtocolVersion {
+	return ProtocolVersion{
+		Hash:         hash,
+		Number:       number,
+		Name:         name,
+		Tenderbake:   number >= 12,
+		TxRollups:    number >= 13 && number <= 15,
+		SmartRollups: number >= 16,
+		Attestations: number >= 18,
+		Staking:      number >= 18,
+	}
+}
+
+// ProtocolRegistry - resolves protocol versions by hash. Unknown protocols are considered as the newest one,
+// so the registry keeps working after protocol upgrades. Decoding of RPC responses doesn't depend on it.
+type ProtocolRegistry struct {
+	mx     sync.RWMutex
+	hashes map[string]ProtocolVersion
+}
+
+// NewProtocolRegistry - creates registry with protocols from Babylon to Seoul
+func NewProtocolRegistry() *ProtocolRegistry {
+	return &ProtocolRegistry{
+		hashes: make(map[string]ProtocolVersion),
+	}
+}
+
+// Register - adds or replaces protocol with the hash. It can be used for test networks or protocols unknown to the registry.
+func (r *ProtocolRegistry) Register(version ProtocolVersion) {
+	r.mx.Lock()
+	r.hashes[version.Hash] = version
+	r.mx.Unlock()
+}
+
+// Get - returns protocol by hash. It returns false if the protocol is unknown.
+func (r *ProtocolRegistry) Get(hash string) (ProtocolVersion, bool) {
+	r.mx.RLock()
+	version, ok := r.hashes[hash]
+	r.mx.RUnlock()
+	if ok {
+		return version, true
+	}
+
+	for _, known := range knownProtocols {
+		if strings.HasPrefix(hash, known.prefix) {
+			return newProtocolVersion(hash, known.number, known.name), true
+		}
+	}
+	return ProtocolVersion{}, false
+}
+
+// Lookup - returns protocol by hash. Features of the newest known protocol are returned for unknown hash.
+func (r *ProtocolRegistry) Lookup(hash string) ProtocolVersion {
+	if version, ok := r.Get(hash); ok {
+		return version
+	}
+	latest := knownProtocols[len(knownProtocols)-1]
+	return newProtocolVersion(hash, latest.number+1, "")
+}
+
+// Resolve - returns protocol of the block `blockID`
+func (r *ProtocolRegistry) Resolve(ctx context.Context, api BlockAPI, blockID string) (ProtocolVersion, error) {
+	protocols, err := api.BlockProtocols(ctx, blockID)
+	if err != nil {
+		return ProtocolVersion{}, err
+	}
+	return r.Lookup(protocols.Protocol), nil
+}
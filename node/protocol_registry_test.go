@@ -0,0 +1,154 @@
+package node
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeKind(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{kind: KindAttestation, want: KindEndorsement},
+		{kind: KindAttestationWithDal, want: KindEndorsementWithDal},
+		{kind: KindPreattestation, want: KindPreendorsement},
+		{kind: KindDoubleAttestation, want: KindDoubleEndorsing},
+		{kind: KindDoublePreattestation, want: KindDoublePreendorsement},
+		{kind: KindEndorsement, want: KindEndorsement},
+		{kind: KindTransaction, want: KindTransaction},
+		{kind: "unknown", want: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			require.Equal(t, tt.want, NormalizeKind(tt.kind))
+			require.Equal(t, tt.want, Operation{Kind: tt.kind}.NormalizedKind())
+		})
+	}
+}
+
+func TestProtocolVersion_Kind(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		kind     string
+		want     string
+	}{
+		{name: "endorsement before oxford", protocol: "PtNairobiyssHuh87hEhfVBGCVrK3WnS8Z2FT4ymB5tAa4r1nQf", kind: KindEndorsement, want: KindEndorsement},
+		{name: "attestation before oxford", protocol: "PtNairobiyssHuh87hEhfVBGCVrK3WnS8Z2FT4ymB5tAa4r1nQf", kind: KindAttestation, want: KindEndorsement},
+		{name: "endorsement since oxford", protocol: "ProxfordYmVfjWnRcgjWH36fW6PArwqykTFzotUxRs6gmTcZDuH", kind: KindEndorsement, want: KindAttestation},
+		{name: "endorsement with dal since oxford", protocol: "ProxfordYmVfjWnRcgjWH36fW6PArwqykTFzotUxRs6gmTcZDuH", kind: KindEndorsementWithDal, want: KindAttestationWithDal},
+		{name: "preendorsement since oxford", protocol: "PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg", kind: KindPreendorsement, want: KindPreattestation},
+		{name: "double endorsing since oxford", protocol: "PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg", kind: KindDoubleEndorsing, want: KindDoubleAttestation},
+		{name: "double preendorsement since oxford", protocol: "PsQuebecnLByd3JwTiGadoG4nGWi3HYiLXUjkibeFV8dCFeVMUg", kind: KindDoublePreendorsement, want: KindDoublePreattestation},
+		{name: "attestation since oxford", protocol: "PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh", kind: KindAttestation, want: KindAttestation},
+		{name: "transaction", protocol: "PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh", kind: KindTransaction, want: KindTransaction},
+	}
+	registry := NewProtocolRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, registry.Lookup(tt.protocol).Kind(tt.kind))
+		})
+	}
+}
+
+func TestProtocolRegistry(t *testing.T) {
+	registry := NewProtocolRegistry()
+	registry.Register(ProtocolVersion{
+		Hash:       "PtCustomTestnet",
+		Number:     12,
+		Name:       "custom",
+		Tenderbake: true,
+	})
+	// registered protocol overrides prefix matching
+	registry.Register(ProtocolVersion{
+		Hash:   "PtSeouLouOverridden",
+		Number: 100,
+		Name:   "overridden",
+	})
+
+	tests := []struct {
+		name      string
+		hash      string
+		want      ProtocolVersion
+		wantKnown bool
+	}{
+		{
+			name:      "babylon",
+			hash:      "PsBabyM1eUXZseaJdmXFApDSBqj8YBfwELoxZHHW77EMcAbbwAS",
+			want:      ProtocolVersion{Hash: "PsBabyM1eUXZseaJdmXFApDSBqj8YBfwELoxZHHW77EMcAbbwAS", Number: 5, Name: "babylon"},
+			wantKnown: true,
+		}, {
+			name:      "ithaca",
+			hash:      "Psithaca2MLRFYargivpo7YvUr7wUDqyxrdhC5CQq78mRvimz6A",
+			want:      ProtocolVersion{Hash: "Psithaca2MLRFYargivpo7YvUr7wUDqyxrdhC5CQq78mRvimz6A", Number: 12, Name: "ithaca", Tenderbake: true},
+			wantKnown: true,
+		}, {
+			name:      "jakarta",
+			hash:      "PtJakart2xVj7pYXJBXrqHgd82rdkLey5ZeeGwDgPp9rhQUbSqY",
+			want:      ProtocolVersion{Hash: "PtJakart2xVj7pYXJBXrqHgd82rdkLey5ZeeGwDgPp9rhQUbSqY", Number: 13, Name: "jakarta", Tenderbake: true, TxRollups: true},
+			wantKnown: true,
+		}, {
+			name:      "mumbai",
+			hash:      "PtMumbai2TmsJHNGRkD8v8YDbtao7BLUC3wjASn1inAKLFCjaH1",
+			want:      ProtocolVersion{Hash: "PtMumbai2TmsJHNGRkD8v8YDbtao7BLUC3wjASn1inAKLFCjaH1", Number: 16, Name: "mumbai", Tenderbake: true, SmartRollups: true},
+			wantKnown: true,
+		}, {
+			name:      "paris C build is matched by prefix",
+			hash:      "PsParisCZo7KAh1Z1smVd9ZMZ1HHn5gkzbM94V3PLCpknFWhUAi",
+			want:      ProtocolVersion{Hash: "PsParisCZo7KAh1Z1smVd9ZMZ1HHn5gkzbM94V3PLCpknFWhUAi", Number: 20, Name: "paris", Tenderbake: true, SmartRollups: true, Attestations: true, Staking: true},
+			wantKnown: true,
+		}, {
+			name:      "seoul",
+			hash:      "PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh",
+			want:      ProtocolVersion{Hash: "PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh", Number: 23, Name: "seoul", Tenderbake: true, SmartRollups: true, Attestations: true, Staking: true},
+			wantKnown: true,
+		}, {
+			name:      "registered",
+			hash:      "PtCustomTestnet",
+			want:      ProtocolVersion{Hash: "PtCustomTestnet", Number: 12, Name: "custom", Tenderbake: true},
+			wantKnown: true,
+		}, {
+			name:      "registered overrides prefix",
+			hash:      "PtSeouLouOverridden",
+			want:      ProtocolVersion{Hash: "PtSeouLouOverridden", Number: 100, Name: "overridden"},
+			wantKnown: true,
+		}, {
+			name: "unknown is the newest",
+			hash: "PtUnknownProtocolHash",
+			want: ProtocolVersion{Hash: "PtUnknownProtocolHash", Number: 24, Tenderbake: true, SmartRollups: true, Attestations: true, Staking: true},
+		}, {
+			name: "empty hash",
+			hash: "",
+			want: ProtocolVersion{Number: 24, Tenderbake: true, SmartRollups: true, Attestations: true, Staking: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, ok := registry.Get(tt.hash)
+			require.Equal(t, tt.wantKnown, ok)
+			if ok {
+				require.Equal(t, tt.want, version)
+			} else {
+				require.Equal(t, ProtocolVersion{}, version)
+			}
+			require.Equal(t, tt.want, registry.Lookup(tt.hash))
+		})
+	}
+}
+
+func TestProtocolRegistry_Resolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/chains/main/blocks/head/protocols", r.URL.Path)
+		_, _ = w.Write([]byte(`{"protocol":"PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh","next_protocol":"PtSeouLouXkxhg39oWzjxDWaCydNfR3RxCUrNe4Q9Ro8BTehcbh"}`))
+	}))
+	defer server.Close()
+
+	version, err := NewProtocolRegistry().Resolve(t.Context(), NewMainBlockRPC(server.URL), HeadBlock)
+	require.NoError(t, err)
+	require.Equal(t, 23, version.Number)
+	require.Equal(t, "seoul", version.Name)
+}
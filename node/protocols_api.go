@@ -19,10 +19,10 @@ type Protocols struct {
 }
 
 // NewProtocols -
-func NewProtocols(baseURL string) *Protocols {
+func NewProtocols(baseURL string, opts ...ClientOption) *Protocols {
 	return &Protocols{
 		baseURL: baseURL,
-		client:  newClient(),
+		client:  newClient(opts...),
 	}
 }
 
@@ -12,9 +12,10 @@ import (
 )
 
 type request struct {
-	link       *url.URL
-	method     string
-	bodyReader io.ReadWriter
+	link   *url.URL
+	uri    string
+	method string
+	body   []byte
 }
 
 func newRequest(baseURL, uri, method string, query url.Values, body interface{}) (*request, error) {
@@ -30,13 +31,14 @@ func newRequest(baseURL, uri, method string, query url.Values, body interface{})
 		link.RawQuery = query.Encode()
 	}
 	req.link = link
+	req.uri = uri
 
 	if body != nil {
-		bodyReader := new(bytes.Buffer)
-		if err := json.NewEncoder(bodyReader).Encode(body); err != nil {
+		bodyBuffer := new(bytes.Buffer)
+		if err := json.NewEncoder(bodyBuffer).Encode(body); err != nil {
 			return nil, err
 		}
-		req.bodyReader = bodyReader
+		req.body = bodyBuffer.Bytes()
 	}
 
 	req.method = method
@@ -52,11 +54,18 @@ func newPostRequest(baseURL, uri string, query url.Values, body interface{}) (*r
 }
 
 func (r *request) do(ctx context.Context, client *client) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, r.method, r.link.String(), r.bodyReader)
-	if err != nil {
-		return nil, errors.Errorf("request.do: %v", err)
-	}
-	return client.Do(req) //nolint:gosec
+	return client.do(ctx, r.uri, func(ctx context.Context) (*http.Request, error) {
+		// body is recreated for every attempt because the previous one was drained
+		var body io.Reader
+		if r.body != nil {
+			body = bytes.NewReader(r.body)
+		}
+		req, err := http.NewRequestWithContext(ctx, r.method, r.link.String(), body)
+		if err != nil {
+			return nil, errors.Errorf("request.do: %v", err)
+		}
+		return req, nil
+	})
 }
 
 func (r *request) checkStatusCode(resp *http.Response) error {
@@ -88,7 +97,28 @@ func (r *request) doWithJSONResponse(ctx context.Context, client *client, respon
 		return err
 	}
 
-	return json.NewDecoder(resp.Body).Decode(response)
+	body := &bodyReader{Reader: resp.Body}
+	if err := json.NewDecoder(body).Decode(response); err != nil {
+		if body.err != nil {
+			return body.err
+		}
+		return DecodeError{Err: err}
+	}
+	return nil
+}
+
+// bodyReader - remembers error of reading response body to separate connection errors from decoding ones
+type bodyReader struct {
+	io.Reader
+	err error
+}
+
+func (r *bodyReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.err = err
+	}
+	return n, err
 }
 
 func (r *request) doWithBytesResponse(ctx context.Context, client *client) ([]byte, error) {
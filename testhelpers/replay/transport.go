@@ -0,0 +1,289 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Mode - behaviour of transport
+type Mode int
+
+// modes
+const (
+	// ModeReplay - responses are read from fixture. Request without fixture fails with `ErrNoFixture`.
+	ModeReplay Mode = iota
+	// ModeRecord - requests are sent to real service and fixture is rewritten with received responses
+	ModeRecord
+	// ModeReplayOrRecord - responses are read from fixture, missing ones are requested and appended to fixture
+	ModeReplayOrRecord
+)
+
+// ErrNoFixture - is returned in replay mode if fixture doesn't contain the request
+var ErrNoFixture = errors.New("no fixture for request")
+
+// Request - stored request. Headers are not stored, so secrets don't leak to fixtures.
+type Request struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// RawBody - body which is not valid JSON
+	RawBody string `json:"raw_body,omitempty"`
+}
+
+// Response - stored response
+type Response struct {
+	StatusCode int             `json:"status_code"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	// RawBody - body which is not valid JSON
+	RawBody string `json:"raw_body,omitempty"`
+}
+
+// Interaction - request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Option -
+type Option func(*Transport)
+
+// WithTransport - sets transport used for requests in record modes. Default: `http.DefaultTransport`.
+func WithTransport(next http.RoundTripper) Option {
+	return func(t *Transport) {
+		if next != nil {
+			t.next = next
+		}
+	}
+}
+
+// Transport - `http.RoundTripper` which records request/response pairs to JSON fixture and replays them.
+// Requests are matched by method, URL and body. Identical requests are replayed in recorded order,
+// the last response is repeated when they are exhausted.
+type Transport struct {
+	path string
+	mode Mode
+	next http.RoundTripper
+
+	mx           sync.Mutex
+	interactions []Interaction
+	used         map[int]struct{}
+}
+
+// New - creates transport over fixture file `path`. Fixture must exist in replay mode.
+func New(path string, mode Mode, opts ...Option) (*Transport, error) {
+	t := &Transport{
+		path: path,
+		mode: mode,
+		next: http.DefaultTransport,
+		used: make(map[int]struct{}),
+	}
+	for i := range opts {
+		opts[i](t)
+	}
+
+	if mode == ModeRecord {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &t.interactions); err != nil {
+			return nil, fmt.Errorf("decoding fixture %s: %w", path, err)
+		}
+	case errors.Is(err, fs.ErrNotExist) && mode == ModeReplayOrRecord:
+	default:
+		return nil, fmt.Errorf("reading fixture: %w", err)
+	}
+	return t, nil
+}
+
+// Interactions - returns copy of recorded interactions
+func (t *Transport) Interactions() []Interaction {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	result := make([]Interaction, len(t.interactions))
+	copy(result, t.interactions)
+	return result
+}
+
+// RoundTrip -
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode != ModeRecord {
+		if response, ok := t.find(request); ok {
+			return response.toHTTP(req), nil
+		}
+		if t.mode == ModeReplay {
+			return nil, fmt.Errorf("%w: %s %s", ErrNoFixture, request.Method, request.URL)
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := newResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.record(Interaction{request, response}); err != nil {
+		return nil, err
+	}
+	return response.toHTTP(req), nil
+}
+
+func (t *Transport) find(request Request) (Response, bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	last := -1
+	for i := range t.interactions {
+		if !request.equal(t.interactions[i].Request) {
+			continue
+		}
+		if _, ok := t.used[i]; !ok {
+			t.used[i] = struct{}{}
+			return t.interactions[i].Response, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Response{}, false
+	}
+	return t.interactions[last].Response, true
+}
+
+func (t *Transport) record(interaction Interaction) error {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.used[len(t.interactions)] = struct{}{}
+	t.interactions = append(t.interactions, interaction)
+
+	data, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("creating fixture directory: %w", err)
+	}
+	if err := os.WriteFile(t.path, data, 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("writing fixture: %w", err)
+	}
+	return nil
+}
+
+func newRequest(req *http.Request) (Request, error) {
+	request := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return request, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return request, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	request.Body, request.RawBody = splitBody(body)
+	return request, nil
+}
+
+func (r Request) equal(other Request) bool {
+	return r.Method == other.Method && r.URL == other.URL &&
+		r.RawBody == other.RawBody && jsonEqual(r.Body, other.Body)
+}
+
+func newResponse(resp *http.Response) (Response, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Header:     header,
+	}
+	response.Body, response.RawBody = splitBody(body)
+	return response, nil
+}
+
+func (r Response) toHTTP(req *http.Request) *http.Response {
+	body := []byte(r.RawBody)
+	if len(r.Body) > 0 {
+		// fixture is indented, so the body is compacted to return the same bytes as in record mode
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, r.Body); err == nil {
+			body = buf.Bytes()
+		} else {
+			body = r.Body
+		}
+	}
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// splitBody - stores compacted JSON body as object to make fixtures readable and other bodies as string
+func splitBody(body []byte) (json.RawMessage, string) {
+	if len(body) == 0 {
+		return nil, ""
+	}
+	if json.Valid(body) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, body); err == nil {
+			return buf.Bytes(), ""
+		}
+	}
+	return nil, string(body)
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var bufA, bufB bytes.Buffer
+	if err := json.Compact(&bufA, a); err != nil {
+		return false
+	}
+	if err := json.Compact(&bufB, b); err != nil {
+		return false
+	}
+	return bytes.Equal(bufA.Bytes(), bufB.Bytes())
+}
@@ -0,0 +1,106 @@
+package replay
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/text" {
+			_, _ = w.Write([]byte("plain " + r.URL.Query().Get("n")))
+			return
+		}
+		_, _ = w.Write([]byte(`{"calls":` + strconv.Itoa(calls) + `,"request":` + orNull(body) + `}`))
+	}))
+	defer server.Close()
+
+	fixture := filepath.Join(t.TempDir(), "fixtures", "test.json")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   string
+	}{
+		{
+			name:   "get JSON",
+			method: http.MethodGet,
+			path:   "/json",
+			want:   `{"calls":1,"request":null}`,
+		}, {
+			name:   "post JSON",
+			method: http.MethodPost,
+			path:   "/json",
+			body:   `{"a": 1}`,
+			want:   `{"calls":2,"request":{"a":1}}`,
+		}, {
+			name:   "text",
+			method: http.MethodGet,
+			path:   "/text?n=3",
+			want:   "plain 3",
+		},
+	}
+
+	recorder, err := New(fixture, ModeRecord)
+	require.NoError(t, err)
+	for _, tt := range tests {
+		require.Equal(t, tt.want, do(t, recorder, server.URL, tt.method, tt.path, tt.body), tt.name)
+	}
+	require.Equal(t, 3, calls)
+
+	replayer, err := New(fixture, ModeReplay)
+	require.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, do(t, replayer, server.URL, tt.method, tt.path, tt.body))
+		})
+	}
+	require.Equal(t, 3, calls)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/unknown", nil)
+	require.NoError(t, err)
+	_, err = replayer.RoundTrip(req)
+	require.ErrorIs(t, err, ErrNoFixture)
+
+	_, err = New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	require.Error(t, err)
+}
+
+func do(t *testing.T, transport http.RoundTripper, baseURL, method, path, body string) string {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, baseURL+path, reader)
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func orNull(body []byte) string {
+	if len(body) == 0 {
+		return "null"
+	}
+	return string(body)
+}
@@ -0,0 +1,467 @@
+package builder
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/dipdup-io/go-lib/tools/crypto"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/dipdup-io/go-lib/tools/forge"
+	"github.com/pkg/errors"
+)
+
+// default fee parameters of octez baker
+const (
+	DefaultMinimalFee     int64 = 100
+	DefaultNanotezPerByte int64 = 1000
+	DefaultNanotezPerGas  int64 = 100
+	DefaultGasReserve     int64 = 100
+	DefaultStorageReserve int64 = 0
+)
+
+const (
+	statusApplied = "applied"
+	branchBytes   = 32
+)
+
+// RPC - node methods which are used by builder. `*node.RPC` implements it.
+type RPC interface {
+	Header(ctx context.Context, blockID string) (node.Header, error)
+	Constants(ctx context.Context, blockID string) (node.Constants, error)
+	ContractCounter(ctx context.Context, blockID, contract string) (string, error)
+	ContractManagerKey(ctx context.Context, blockID, contract string) (string, error)
+	SimulateOperation(ctx context.Context, blockID string, request node.SimulateOperationRequest) (node.RunOperationResponse, error)
+	InjectOperation(ctx context.Context, request node.InjectOperationRequest) (string, error)
+}
+
+// Builder - builds manager operations of single source: reveals the key if needed, fills counters, estimates limits and fees by simulation, signs and injects the group.
+type Builder struct {
+	rpc    RPC
+	signer crypto.Signer
+	source string
+
+	pubKey *crypto.PubKey
+	mx     sync.Mutex
+
+	minimalFee     int64
+	nanotezPerByte int64
+	nanotezPerGas  int64
+	gasReserve     int64
+	storageReserve int64
+}
+
+// New - creates builder of operations which are signed by `signer` on behalf of `source`. Public key of the source is requested from signer on first build.
+func New(rpc RPC, signer crypto.Signer, source string, opts ...Option) (*Builder, error) {
+	if rpc == nil {
+		return nil, errors.New("nil rpc")
+	}
+	if signer == nil {
+		return nil, errors.New("nil signer")
+	}
+	if source == "" {
+		return nil, errors.New("empty source")
+	}
+
+	b := &Builder{
+		rpc:            rpc,
+		signer:         signer,
+		source:         source,
+		minimalFee:     DefaultMinimalFee,
+		nanotezPerByte: DefaultNanotezPerByte,
+		nanotezPerGas:  DefaultNanotezPerGas,
+		gasReserve:     DefaultGasReserve,
+		storageReserve: DefaultStorageReserve,
+	}
+	for i := range opts {
+		opts[i](b)
+	}
+	return b, nil
+}
+
+// Source - returns address of operations source
+func (b *Builder) Source() string {
+	return b.source
+}
+
+// Group - built operation group which is ready to be signed
+type Group struct {
+	Branch           string
+	ChainID          string
+	Contents         []node.Operation
+	SimulatedResults []node.RunOperationContent
+}
+
+// Result - injected operation group. `SimulatedResults` are receipts of the simulation made during building, the group isn't included in a block yet.
+type Result struct {
+	Hash             string
+	Branch           string
+	Signature        string
+	Contents         []node.Operation
+	SimulatedResults []node.RunOperationContent
+}
+
+// Build - prepends reveal if the source key isn't revealed, sets source and counters, and estimates gas limit, storage limit and fee of every operation.
+// Values which were set by caller are kept. Counters of the next operations continue from the counter set by caller.
+func (b *Builder) Build(ctx context.Context, operations ...node.Operation) (Group, error) {
+	if len(operations) == 0 {
+		return Group{}, errors.New("empty operation list")
+	}
+
+	pubKey, err := b.publicKey(ctx)
+	if err != nil {
+		return Group{}, err
+	}
+
+	header, err := b.rpc.Header(ctx, node.HeadBlock)
+	if err != nil {
+		return Group{}, errors.Wrap(err, "receiving head")
+	}
+	constants, err := b.rpc.Constants(ctx, node.HeadBlock)
+	if err != nil {
+		return Group{}, errors.Wrap(err, "receiving constants")
+	}
+	counterValue, err := b.rpc.ContractCounter(ctx, node.HeadBlock, b.source)
+	if err != nil {
+		return Group{}, errors.Wrap(err, "receiving counter")
+	}
+	counter, ok := new(big.Int).SetString(counterValue, 10)
+	if !ok {
+		return Group{}, errors.Errorf("invalid counter: %s", counterValue)
+	}
+	managerKey, err := b.rpc.ContractManagerKey(ctx, node.HeadBlock, b.source)
+	if err != nil {
+		return Group{}, errors.Wrap(err, "receiving manager key")
+	}
+
+	if managerKey == "" && operations[0].Kind != node.KindReveal {
+		reveal, err := b.reveal(ctx, pubKey)
+		if err != nil {
+			return Group{}, err
+		}
+		operations = append([]node.Operation{reveal}, operations...)
+	}
+
+	gasLimit := constants.HardGasLimitPerOperation
+	if perBlock := constants.HardGasLimitPerBlock / int64(len(operations)); perBlock < gasLimit {
+		gasLimit = perBlock
+	}
+
+	explicit := make([]managerFields, len(operations))
+	contents := make([]node.Operation, len(operations))
+	for i := range operations {
+		fields, err := readManagerFields(operations[i])
+		if err != nil {
+			return Group{}, errors.Wrapf(err, "operation %d", i)
+		}
+		if fields.Source != "" && fields.Source != b.source {
+			return Group{}, errors.Errorf("operation %d has another source: %s", i, fields.Source)
+		}
+		explicit[i] = fields
+
+		if fields.Counter == "" {
+			counter.Add(counter, big.NewInt(1))
+		} else if _, ok := counter.SetString(fields.Counter, 10); !ok {
+			return Group{}, errors.Errorf("operation %d has invalid counter: %s", i, fields.Counter)
+		}
+		fields.Source = b.source
+		fields.Counter = counter.String()
+		fields.Fee = valueOrDefault(fields.Fee, "0")
+		fields.GasLimit = valueOrDefault(fields.GasLimit, big.NewInt(gasLimit).String())
+		fields.StorageLimit = valueOrDefault(fields.StorageLimit, big.NewInt(constants.HardStorageLimitPerOperation).String())
+
+		if contents[i], err = setManagerFields(operations[i], fields); err != nil {
+			return Group{}, errors.Wrapf(err, "operation %d", i)
+		}
+	}
+
+	signature, err := emptySignature(pubKey.SignatureSize())
+	if err != nil {
+		return Group{}, err
+	}
+	simulation, err := b.rpc.SimulateOperation(ctx, node.HeadBlock, node.SimulateOperationRequest{
+		Operation: node.UnsignedOperation{
+			Branch:    header.Hash,
+			Contents:  contents,
+			Signature: signature,
+		},
+		ChainID: header.ChainID,
+	})
+	if err != nil {
+		return Group{}, errors.Wrap(err, "simulation")
+	}
+	if len(simulation.Contents) != len(contents) {
+		return Group{}, errors.Errorf("simulation returned %d results for %d operations", len(simulation.Contents), len(contents))
+	}
+
+	for i := range contents {
+		result := simulation.Contents[i].Metadata
+		if err := checkResult(contents[i].Kind, result); err != nil {
+			return Group{}, errors.Wrapf(err, "operation %d", i)
+		}
+
+		fields, err := readManagerFields(contents[i])
+		if err != nil {
+			return Group{}, err
+		}
+		if explicit[i].GasLimit == "" {
+			gas, err := consumedGas(result)
+			if err != nil {
+				return Group{}, errors.Wrapf(err, "operation %d", i)
+			}
+			gas += b.gasReserve
+			if gas > gasLimit {
+				gas = gasLimit
+			}
+			fields.GasLimit = big.NewInt(gas).String()
+		}
+		if explicit[i].StorageLimit == "" {
+			storage, err := burnedStorage(result, constants.OriginationSize)
+			if err != nil {
+				return Group{}, errors.Wrapf(err, "operation %d", i)
+			}
+			fields.StorageLimit = big.NewInt(storage + b.storageReserve).String()
+		}
+		if contents[i], err = setManagerFields(contents[i], fields); err != nil {
+			return Group{}, err
+		}
+
+		if explicit[i].Fee == "" {
+			var extraBytes int64
+			if i == 0 {
+				extraBytes = int64(branchBytes + pubKey.SignatureSize())
+			}
+			if contents[i], err = b.estimateFee(contents[i], fields, extraBytes); err != nil {
+				return Group{}, errors.Wrapf(err, "operation %d", i)
+			}
+		}
+	}
+
+	return Group{
+		Branch:           header.Hash,
+		ChainID:          header.ChainID,
+		Contents:         contents,
+		SimulatedResults: simulation.Contents,
+	}, nil
+}
+
+// Sign - forges and signs operation group. It returns forged bytes with appended signature.
+func (b *Builder) Sign(ctx context.Context, group Group) ([]byte, crypto.Signature, error) {
+	forged, err := forge.OPG(group.Branch, group.Contents...)
+	if err != nil {
+		return nil, crypto.Signature{}, err
+	}
+	signature, err := b.signer.Sign(ctx, b.source, append([]byte{crypto.WatermarkGenericOperation}, forged...))
+	if err != nil {
+		return nil, crypto.Signature{}, errors.Wrap(err, "signing")
+	}
+	return append(forged, signature.Bytes()...), signature, nil
+}
+
+// Send - builds, signs and injects operations. It doesn't wait for inclusion of the group.
+func (b *Builder) Send(ctx context.Context, operations ...node.Operation) (Result, error) {
+	group, err := b.Build(ctx, operations...)
+	if err != nil {
+		return Result{}, err
+	}
+
+	signed, signature, err := b.Sign(ctx, group)
+	if err != nil {
+		return Result{}, err
+	}
+	encodedSignature, err := signature.Base58()
+	if err != nil {
+		return Result{}, err
+	}
+
+	hash, err := crypto.OperationHash(signed, nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	injected, err := b.rpc.InjectOperation(ctx, node.InjectOperationRequest{
+		Operation: hex.EncodeToString(signed),
+		ChainID:   group.ChainID,
+	})
+	if err != nil {
+		return Result{}, errors.Wrap(err, "injection")
+	}
+	if injected != hash {
+		return Result{}, errors.Errorf("node returned unexpected operation hash: %s != %s", injected, hash)
+	}
+
+	return Result{
+		Hash:             hash,
+		Branch:           group.Branch,
+		Signature:        encodedSignature,
+		Contents:         group.Contents,
+		SimulatedResults: group.SimulatedResults,
+	}, nil
+}
+
+// publicKey - requests public key of the source from signer and checks that it matches the source
+func (b *Builder) publicKey(ctx context.Context) (crypto.PubKey, error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.pubKey != nil {
+		return *b.pubKey, nil
+	}
+	pubKey, err := b.signer.PublicKey(ctx, b.source)
+	if err != nil {
+		return crypto.PubKey{}, errors.Wrap(err, "receiving public key")
+	}
+	address, err := pubKey.Address()
+	if err != nil {
+		return crypto.PubKey{}, errors.Wrap(err, "public key address")
+	}
+	if address != b.source {
+		return crypto.PubKey{}, errors.Errorf("signer returned public key of another address: %s != %s", address, b.source)
+	}
+	b.pubKey = &pubKey
+	return pubKey, nil
+}
+
+func (b *Builder) reveal(ctx context.Context, pubKey crypto.PubKey) (node.Operation, error) {
+	publicKey, err := pubKey.Base58()
+	if err != nil {
+		return node.Operation{}, errors.Wrap(err, "public key")
+	}
+	reveal := node.Reveal{
+		Kind:      node.KindReveal,
+		Source:    b.source,
+		PublicKey: publicKey,
+	}
+	if strings.HasPrefix(publicKey, encoding.PrefixBLS12381PublicKey) {
+		prover, ok := b.signer.(crypto.PossessionProver)
+		if !ok {
+			return node.Operation{}, errors.New("signer can't prove possession of tz4 key: pass reveal with proof explicitly")
+		}
+		proof, err := prover.ProvePossession(ctx, b.source)
+		if err != nil {
+			return node.Operation{}, errors.Wrap(err, "proof of possession")
+		}
+		if reveal.Proof, err = proof.Base58(); err != nil {
+			return node.Operation{}, err
+		}
+	}
+	return node.Operation{
+		Kind: node.KindReveal,
+		Body: reveal,
+	}, nil
+}
+
+// estimateFee - computes minimal fee accepted by bakers. `extraBytes` are added to the size of forged operation: the first operation pays for branch and signature. Fee changes size of forged operation, so it's recomputed until it's stable.
+func (b *Builder) estimateFee(operation node.Operation, fields managerFields, extraBytes int64) (node.Operation, error) {
+	gas, ok := new(big.Int).SetString(fields.GasLimit, 10)
+	if !ok {
+		return operation, errors.Errorf("invalid gas limit: %s", fields.GasLimit)
+	}
+
+	for {
+		forged, err := forge.Operation(operation)
+		if err != nil {
+			return operation, err
+		}
+		size := int64(len(forged)) + extraBytes
+
+		fee := big.NewInt(b.minimalFee)
+		fee.Add(fee, ceilDiv(big.NewInt(size*b.nanotezPerByte), 1000))
+		fee.Add(fee, ceilDiv(new(big.Int).Mul(gas, big.NewInt(b.nanotezPerGas)), 1000))
+
+		if fee.String() == fields.Fee {
+			return operation, nil
+		}
+		fields.Fee = fee.String()
+		if operation, err = setManagerFields(operation, fields); err != nil {
+			return operation, err
+		}
+	}
+}
+
+func checkResult(kind string, metadata node.RunOperationMetadata) error {
+	results := []node.OperationResult{metadata.OperationResult}
+	for i := range metadata.InternalOperationResults {
+		results = append(results, metadata.InternalOperationResults[i].Result)
+	}
+	for i := range results {
+		if results[i].Status == statusApplied {
+			continue
+		}
+		ids := make([]string, 0, len(results[i].Errors))
+		for j := range results[i].Errors {
+			ids = append(ids, results[i].Errors[j].ID)
+		}
+		return errors.Errorf("%s is %s: %s", kind, results[i].Status, strings.Join(ids, ", "))
+	}
+	return nil
+}
+
+func consumedGas(metadata node.RunOperationMetadata) (int64, error) {
+	milligas := new(big.Int)
+	results := []node.OperationResult{metadata.OperationResult}
+	for i := range metadata.InternalOperationResults {
+		results = append(results, metadata.InternalOperationResults[i].Result)
+	}
+	for i := range results {
+		if results[i].ConsumedMilligas == "" {
+			continue
+		}
+		value, ok := new(big.Int).SetString(results[i].ConsumedMilligas, 10)
+		if !ok {
+			return 0, errors.Errorf("invalid consumed milligas: %s", results[i].ConsumedMilligas)
+		}
+		milligas.Add(milligas, value)
+	}
+	return ceilDiv(milligas, 1000).Int64(), nil
+}
+
+func burnedStorage(metadata node.RunOperationMetadata, originationSize int64) (int64, error) {
+	var storage int64
+	results := []node.OperationResult{metadata.OperationResult}
+	for i := range metadata.InternalOperationResults {
+		results = append(results, metadata.InternalOperationResults[i].Result)
+	}
+	for i := range results {
+		if results[i].PaidStorageSizeDiff != "" {
+			value, ok := new(big.Int).SetString(results[i].PaidStorageSizeDiff, 10)
+			if !ok {
+				return 0, errors.Errorf("invalid paid storage size diff: %s", results[i].PaidStorageSizeDiff)
+			}
+			storage += value.Int64()
+		}
+		if results[i].AllocatedDestinationContract {
+			storage += originationSize
+		}
+		storage += int64(len(results[i].OriginatedContracts)) * originationSize
+	}
+	return storage, nil
+}
+
+// emptySignature - zero signature of the signer's size which is used in simulation
+func emptySignature(size int) (string, error) {
+	prefix := encoding.PrefixGenericSignature
+	if size == crypto.BLSSignatureSize {
+		prefix = encoding.PrefixBLS12381Signature
+	}
+	return encoding.EncodeBase58(make([]byte, size), []byte(prefix))
+}
+
+func ceilDiv(value *big.Int, divisor int64) *big.Int {
+	d := big.NewInt(divisor)
+	result, mod := new(big.Int).DivMod(value, d, new(big.Int))
+	if mod.Sign() > 0 {
+		result.Add(result, big.NewInt(1))
+	}
+	return result
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,345 @@
+package builder
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/dipdup-io/go-lib/tools/crypto"
+	"github.com/dipdup-io/go-lib/tools/forge"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRPC struct {
+	managerKey string
+	status     string
+	simulated  node.SimulateOperationRequest
+	injected   node.InjectOperationRequest
+}
+
+func (rpc *fakeRPC) Header(ctx context.Context, blockID string) (node.Header, error) {
+	return node.Header{
+		Hash:    "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+		ChainID: "NetXdQprcVkpaWU",
+	}, nil
+}
+
+func (rpc *fakeRPC) Constants(ctx context.Context, blockID string) (node.Constants, error) {
+	return node.Constants{
+		HardGasLimitPerOperation:     1040000,
+		HardGasLimitPerBlock:         1733333,
+		HardStorageLimitPerOperation: 60000,
+		OriginationSize:              257,
+	}, nil
+}
+
+func (rpc *fakeRPC) ContractCounter(ctx context.Context, blockID, contract string) (string, error) {
+	return "10", nil
+}
+
+func (rpc *fakeRPC) ContractManagerKey(ctx context.Context, blockID, contract string) (string, error) {
+	return rpc.managerKey, nil
+}
+
+func (rpc *fakeRPC) SimulateOperation(ctx context.Context, blockID string, request node.SimulateOperationRequest) (node.RunOperationResponse, error) {
+	rpc.simulated = request
+	var response node.RunOperationResponse
+	for i := range request.Operation.Contents {
+		response.Contents = append(response.Contents, node.RunOperationContent{
+			Operation: request.Operation.Contents[i],
+			Metadata: node.RunOperationMetadata{
+				OperationResult: node.OperationResult{
+					Status:                       rpc.status,
+					ConsumedMilligas:             "1000001",
+					AllocatedDestinationContract: request.Operation.Contents[i].Kind == node.KindTransaction,
+				},
+			},
+		})
+	}
+	return response, nil
+}
+
+func (rpc *fakeRPC) InjectOperation(ctx context.Context, request node.InjectOperationRequest) (string, error) {
+	rpc.injected = request
+	signed, err := hex.DecodeString(request.Operation)
+	if err != nil {
+		return "", err
+	}
+	return crypto.OperationHash(signed, nil)
+}
+
+// signerWithoutProof - hides `ProvePossession` of wrapped signer
+type signerWithoutProof struct {
+	crypto.Signer
+}
+
+func TestBuilder_Send(t *testing.T) {
+	key, err := crypto.NewKeyFromBase58("edsk2tbfnJFHrV7R8XxA8fKGFdsPotvkPLDqTa12XMMQ9v8yZ5A7zy")
+	require.NoError(t, err)
+	blsKey, err := crypto.NewKey(crypto.KindBLS)
+	require.NoError(t, err)
+	memory, err := crypto.NewMemorySigner(key, blsKey)
+	require.NoError(t, err)
+
+	transaction := node.Operation{
+		Kind: node.KindTransaction,
+		Body: node.Transaction{
+			Amount:      "1000",
+			Destination: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		key        crypto.Key
+		signer     crypto.Signer
+		managerKey string
+		status     string
+		operations []node.Operation
+		wantKinds  []string
+		wantErr    bool
+	}{
+		{
+			name:       "auto reveal",
+			key:        key,
+			signer:     memory,
+			status:     "applied",
+			operations: []node.Operation{transaction},
+			wantKinds:  []string{node.KindReveal, node.KindTransaction},
+		}, {
+			name:       "auto reveal of tz4",
+			key:        blsKey,
+			signer:     memory,
+			status:     "applied",
+			operations: []node.Operation{transaction},
+			wantKinds:  []string{node.KindReveal, node.KindTransaction},
+		}, {
+			name:       "tz4 signer without proof of possession",
+			key:        blsKey,
+			signer:     signerWithoutProof{memory},
+			status:     "applied",
+			operations: []node.Operation{transaction},
+			wantErr:    true,
+		}, {
+			name:       "revealed tz4",
+			key:        blsKey,
+			signer:     signerWithoutProof{memory},
+			managerKey: "BLpk1ur5XXicWYMMzCVZZWyLZhybtyX8Zot2uCzDCZW8KcC5BdZiLVXRZvZzi4GuZYL9SarUvKpE",
+			status:     "applied",
+			operations: []node.Operation{transaction},
+			wantKinds:  []string{node.KindTransaction},
+		}, {
+			name:       "revealed",
+			key:        key,
+			signer:     memory,
+			managerKey: "edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ",
+			status:     "applied",
+			operations: []node.Operation{transaction},
+			wantKinds:  []string{node.KindTransaction},
+		}, {
+			name:       "failed simulation",
+			key:        key,
+			signer:     memory,
+			managerKey: "edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ",
+			status:     "failed",
+			operations: []node.Operation{transaction},
+			wantErr:    true,
+		}, {
+			name:    "empty operations",
+			key:     key,
+			signer:  memory,
+			status:  "applied",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &fakeRPC{
+				managerKey: tt.managerKey,
+				status:     tt.status,
+			}
+			source, err := tt.key.Address()
+			require.NoError(t, err)
+			b, err := New(rpc, tt.signer, source)
+			require.NoError(t, err)
+
+			result, err := b.Send(context.Background(), tt.operations...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result.Contents, len(tt.wantKinds))
+			require.Equal(t, "NetXdQprcVkpaWU", rpc.injected.ChainID)
+			require.Equal(t, "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL", rpc.simulated.Operation.Branch)
+
+			signed, err := hex.DecodeString(rpc.injected.Operation)
+			require.NoError(t, err)
+			group, err := forge.UnforgeOPG(signed, tt.key.PublicKey().SignatureSize())
+			require.NoError(t, err)
+			require.Len(t, group.Contents, len(tt.wantKinds))
+
+			simulated, err := crypto.NewSignatureFromBase58(rpc.simulated.Operation.Signature)
+			require.NoError(t, err)
+			require.Len(t, simulated.Bytes(), tt.key.PublicKey().SignatureSize())
+
+			ok, err := crypto.VerifySignedOperation(tt.key.PublicKey(), signed)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			hash, err := crypto.OperationHash(signed, nil)
+			require.NoError(t, err)
+			require.Equal(t, hash, result.Hash)
+
+			for i := range tt.wantKinds {
+				require.Equal(t, tt.wantKinds[i], group.Contents[i].Kind)
+
+				fields, err := readManagerFields(group.Contents[i])
+				require.NoError(t, err)
+				require.Equal(t, b.Source(), fields.Source)
+				require.Equal(t, strconv.Itoa(11+i), fields.Counter)
+				require.Equal(t, "1101", fields.GasLimit)
+				if tt.wantKinds[i] == node.KindTransaction {
+					require.Equal(t, "257", fields.StorageLimit)
+				} else {
+					require.Equal(t, "0", fields.StorageLimit)
+				}
+				require.NotEqual(t, "0", fields.Fee)
+
+				if reveal, ok := group.Contents[i].Body.(node.Reveal); ok && tt.key.PublicKey().SignatureSize() == crypto.BLSSignatureSize {
+					proof, err := crypto.NewSignatureFromBase58(reveal.Proof)
+					require.NoError(t, err)
+					require.True(t, tt.key.PublicKey().VerifyPossession(proof.Bytes()))
+				}
+			}
+		})
+	}
+}
+
+func TestBuilder_estimateFee(t *testing.T) {
+	b := &Builder{
+		minimalFee:     DefaultMinimalFee,
+		nanotezPerByte: DefaultNanotezPerByte,
+		nanotezPerGas:  DefaultNanotezPerGas,
+	}
+	fields := managerFields{
+		Source:       "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+		Fee:          "0",
+		Counter:      "393218",
+		GasLimit:     "1521",
+		StorageLimit: "257",
+	}
+	operation, err := setManagerFields(node.Operation{
+		Kind: node.KindTransaction,
+		Body: node.Transaction{
+			Amount:      "1000",
+			Destination: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+		},
+	}, fields)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		extraBytes int64
+		want       string
+	}{
+		{
+			// 100 + 56 bytes * 1 + ceil(1521 * 0.1)
+			name: "without branch and signature",
+			want: "309",
+		}, {
+			// 100 + (56 bytes + 32 bytes of branch + 64 bytes of signature) * 1 + ceil(1521 * 0.1)
+			name:       "with generic signature",
+			extraBytes: branchBytes + crypto.SignatureSize,
+			want:       "405",
+		}, {
+			// 100 + (56 bytes + 32 bytes of branch + 96 bytes of BLS signature) * 1 + ceil(1521 * 0.1)
+			name:       "with BLS signature",
+			extraBytes: branchBytes + crypto.BLSSignatureSize,
+			want:       "437",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.estimateFee(operation, fields, tt.extraBytes)
+			require.NoError(t, err)
+
+			tx, err := node.NewTypedOperation[node.Transaction](got)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tx.Fee)
+		})
+	}
+}
+
+func TestBuilder_BuildCounter(t *testing.T) {
+	key, err := crypto.NewKeyFromBase58("edsk2tbfnJFHrV7R8XxA8fKGFdsPotvkPLDqTa12XMMQ9v8yZ5A7zy")
+	require.NoError(t, err)
+	signer, err := crypto.NewMemorySigner(key)
+	require.NoError(t, err)
+	source, err := key.Address()
+	require.NoError(t, err)
+
+	transaction := func(counter string) node.Operation {
+		return node.Operation{
+			Kind: node.KindTransaction,
+			Body: node.Transaction{
+				Counter:     counter,
+				Amount:      "1000",
+				Destination: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		counters []string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "from node",
+			counters: []string{"", ""},
+			want:     []string{"11", "12"},
+		}, {
+			name:     "set by caller",
+			counters: []string{"100", ""},
+			want:     []string{"100", "101"},
+		}, {
+			name:     "set by caller in the middle",
+			counters: []string{"", "50", ""},
+			want:     []string{"11", "50", "51"},
+		}, {
+			name:     "invalid",
+			counters: []string{"abc"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(&fakeRPC{managerKey: key.PublicKey().String(), status: "applied"}, signer, source)
+			require.NoError(t, err)
+
+			operations := make([]node.Operation, len(tt.counters))
+			for i := range tt.counters {
+				operations[i] = transaction(tt.counters[i])
+			}
+			group, err := b.Build(t.Context(), operations...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, group.SimulatedResults, len(tt.want))
+
+			counters := make([]string, len(group.Contents))
+			for i := range group.Contents {
+				fields, err := readManagerFields(group.Contents[i])
+				require.NoError(t, err)
+				counters[i] = fields.Counter
+			}
+			require.Equal(t, tt.want, counters)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package builder
+
+import (
+	stdJSON "encoding/json"
+
+	"github.com/dipdup-io/go-lib/node"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// managerFields - common fields of manager operations
+type managerFields struct {
+	Source       string `json:"source"`
+	Fee          string `json:"fee"`
+	Counter      string `json:"counter"`
+	GasLimit     string `json:"gas_limit"`
+	StorageLimit string `json:"storage_limit"`
+}
+
+func readManagerFields(operation node.Operation) (managerFields, error) {
+	var fields managerFields
+	if operation.Body == nil {
+		return fields, errors.Errorf("empty body of %s", operation.Kind)
+	}
+	data, err := json.Marshal(operation)
+	if err != nil {
+		return fields, err
+	}
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
+
+// setManagerFields - returns copy of operation with replaced manager fields. Body keeps its type.
+func setManagerFields(operation node.Operation, fields managerFields) (node.Operation, error) {
+	data, err := json.Marshal(operation)
+	if err != nil {
+		return operation, err
+	}
+	var body map[string]stdJSON.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		return operation, err
+	}
+	for key, value := range map[string]string{
+		"source":        fields.Source,
+		"fee":           fields.Fee,
+		"counter":       fields.Counter,
+		"gas_limit":     fields.GasLimit,
+		"storage_limit": fields.StorageLimit,
+	} {
+		if body[key], err = json.Marshal(value); err != nil {
+			return operation, err
+		}
+	}
+	if data, err = json.Marshal(body); err != nil {
+		return operation, err
+	}
+
+	var result node.Operation
+	if err := json.Unmarshal(data, &result); err != nil {
+		return operation, err
+	}
+	if result.Body == nil {
+		return operation, errors.Errorf("%s is not a manager operation", operation.Kind)
+	}
+	return result, nil
+}
@@ -0,0 +1,27 @@
+package builder
+
+// Option -
+type Option func(*Builder)
+
+// WithFeeParameters - sets minimal fee (mutez), fee per byte and fee per gas unit (nanotez) which are used in fee estimation
+func WithFeeParameters(minimalFee, nanotezPerByte, nanotezPerGas int64) Option {
+	return func(b *Builder) {
+		b.minimalFee = minimalFee
+		b.nanotezPerByte = nanotezPerByte
+		b.nanotezPerGas = nanotezPerGas
+	}
+}
+
+// WithGasReserve - sets gas which is added to consumed gas of every operation
+func WithGasReserve(gas int64) Option {
+	return func(b *Builder) {
+		b.gasReserve = gas
+	}
+}
+
+// WithStorageReserve - sets storage which is added to burned storage of every operation
+func WithStorageReserve(storage int64) Option {
+	return func(b *Builder) {
+		b.storageReserve = storage
+	}
+}
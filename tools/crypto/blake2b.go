@@ -15,3 +15,9 @@ func Blake2b160(data []byte) ([]byte, error) {
 
 	return hash.Sum(nil), nil
 }
+
+// Blake2b256 -
+func Blake2b256(data []byte) []byte {
+	hash := blake2b.Sum256(data)
+	return hash[:]
+}
@@ -0,0 +1,250 @@
+package crypto
+
+import (
+	"math/big"
+	"slices"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+)
+
+// BLS ciphersuites used by Tezos (MinPk variant: public keys in G1, signatures in G2)
+const (
+	blsAugmentedDST         = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_"
+	blsProofOfPossessionDST = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+const (
+	blsPrivateKeySize = fr.Bytes
+	blsPublicKeySize  = bls12381.SizeOfG1AffineCompressed
+	blsSignatureSize  = bls12381.SizeOfG2AffineCompressed
+)
+
+// BLS - BLS12-381 curve of tz4 accounts. Messages are signed as is (without hashing) using augmented scheme.
+type BLS struct {
+	addressPrefix    []byte
+	publicKeyPrefix  []byte
+	privateKeyPrefix []byte
+	signaturePrefix  []byte
+}
+
+// NewBLS -
+func NewBLS() BLS {
+	return BLS{
+		addressPrefix:    []byte(encoding.PrefixPublicKeyTZ4),
+		publicKeyPrefix:  []byte(encoding.PrefixBLS12381PublicKey),
+		privateKeyPrefix: []byte(encoding.PrefixBLS12381SecretKey),
+		signaturePrefix:  []byte(encoding.PrefixBLS12381Signature),
+	}
+}
+
+// GeneratePrivateKey -
+func (curve BLS) GeneratePrivateKey() ([]byte, []byte, error) {
+	var scalar fr.Element
+	for scalar.IsZero() {
+		if _, err := scalar.SetRandom(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// secret key is stored in little-endian as octez does
+	sk := scalar.Bytes()
+	slices.Reverse(sk[:])
+
+	pk, err := curve.GetPublicKey(sk[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return pk, sk[:], nil
+}
+
+// GetPublicKey -
+func (curve BLS) GetPublicKey(privateKey []byte) ([]byte, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var pk bls12381.G1Affine
+	pk.ScalarMultiplicationBase(sk)
+	pkBytes := pk.Bytes()
+	return pkBytes[:], nil
+}
+
+// Sign -
+func (curve BLS) Sign(data []byte, privateKey []byte) (Signature, error) {
+	pk, err := curve.GetPublicKey(privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+	return curve.sign(append(pk, data...), privateKey, blsAugmentedDST)
+}
+
+// Verify -
+func (curve BLS) Verify(data []byte, signature []byte, pubKey []byte) bool {
+	return curve.verify(append(slices.Clone(pubKey), data...), signature, pubKey, blsAugmentedDST)
+}
+
+// ProvePossession - returns proof that the owner of public key knows the private key. It is required to reveal tz4 account.
+func (curve BLS) ProvePossession(privateKey []byte) (Signature, error) {
+	pk, err := curve.GetPublicKey(privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+	return curve.sign(pk, privateKey, blsProofOfPossessionDST)
+}
+
+// VerifyPossession -
+func (curve BLS) VerifyPossession(pubKey []byte, proof []byte) bool {
+	return curve.verify(pubKey, proof, pubKey, blsProofOfPossessionDST)
+}
+
+// AggregateSignatures - sums signatures into the single one
+func (curve BLS) AggregateSignatures(signatures ...[]byte) (Signature, error) {
+	if len(signatures) == 0 {
+		return Signature{}, errors.New("empty signatures list")
+	}
+
+	var aggregated bls12381.G2Jac
+	for i := range signatures {
+		sig, err := parseBLSSignature(signatures[i])
+		if err != nil {
+			return Signature{}, errors.Wrapf(err, "signature %d", i)
+		}
+		aggregated.AddMixed(&sig)
+	}
+
+	var result bls12381.G2Affine
+	result.FromJacobian(&aggregated)
+	resultBytes := result.Bytes()
+	return NewSignature(resultBytes[:], curve.signaturePrefix), nil
+}
+
+// AggregateVerify - verifies aggregated signature of messages signed by corresponding public keys
+func (curve BLS) AggregateVerify(data [][]byte, signature []byte, pubKeys [][]byte) bool {
+	if len(data) == 0 || len(data) != len(pubKeys) {
+		return false
+	}
+
+	sig, err := parseBLSSignature(signature)
+	if err != nil {
+		return false
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	g1.Neg(&g1)
+
+	g1Points := make([]bls12381.G1Affine, 0, len(pubKeys)+1)
+	g2Points := make([]bls12381.G2Affine, 0, len(pubKeys)+1)
+	for i := range pubKeys {
+		pk, err := parseBLSPublicKey(pubKeys[i])
+		if err != nil {
+			return false
+		}
+		msg, err := bls12381.HashToG2(append(slices.Clone(pubKeys[i]), data[i]...), []byte(blsAugmentedDST))
+		if err != nil {
+			return false
+		}
+		g1Points = append(g1Points, pk)
+		g2Points = append(g2Points, msg)
+	}
+	g1Points = append(g1Points, g1)
+	g2Points = append(g2Points, sig)
+
+	ok, err := bls12381.PairingCheck(g1Points, g2Points)
+	return err == nil && ok
+}
+
+// AddressPrefix -
+func (curve BLS) AddressPrefix() []byte {
+	return curve.addressPrefix
+}
+
+// PublicKeyPrefix -
+func (curve BLS) PublicKeyPrefix() []byte {
+	return curve.publicKeyPrefix
+}
+
+// Kind -
+func (curve BLS) Kind() ECKind {
+	return KindBLS
+}
+
+func (curve BLS) sign(msg, privateKey []byte, dst string) (Signature, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	point, err := bls12381.HashToG2(msg, []byte(dst))
+	if err != nil {
+		return Signature{}, err
+	}
+
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&point, sk)
+	sigBytes := sig.Bytes()
+	return NewSignature(sigBytes[:], curve.signaturePrefix), nil
+}
+
+func (curve BLS) verify(msg, signature, pubKey []byte, dst string) bool {
+	pk, err := parseBLSPublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	sig, err := parseBLSSignature(signature)
+	if err != nil {
+		return false
+	}
+
+	point, err := bls12381.HashToG2(msg, []byte(dst))
+	if err != nil {
+		return false
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	g1.Neg(&g1)
+
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{pk, g1}, []bls12381.G2Affine{point, sig})
+	return err == nil && ok
+}
+
+func (curve BLS) parsePrivateKey(privateKey []byte) (*big.Int, error) {
+	if blsPrivateKeySize != len(privateKey) {
+		return nil, errors.Errorf("invalid private key length: %d != %d", len(privateKey), blsPrivateKeySize)
+	}
+
+	be := slices.Clone(privateKey)
+	slices.Reverse(be)
+
+	sk := new(big.Int).SetBytes(be)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return nil, errors.New("invalid bls12_381 private key")
+	}
+	return sk, nil
+}
+
+func parseBLSPublicKey(data []byte) (bls12381.G1Affine, error) {
+	var pk bls12381.G1Affine
+	if blsPublicKeySize != len(data) {
+		return pk, errors.Errorf("invalid public key length: %d != %d", len(data), blsPublicKeySize)
+	}
+	if _, err := pk.SetBytes(data); err != nil {
+		return pk, err
+	}
+	if pk.IsInfinity() {
+		return pk, errors.New("public key is the point at infinity")
+	}
+	return pk, nil
+}
+
+func parseBLSSignature(data []byte) (bls12381.G2Affine, error) {
+	var sig bls12381.G2Affine
+	if blsSignatureSize != len(data) {
+		return sig, errors.Errorf("invalid signature length: %d != %d", len(data), blsSignatureSize)
+	}
+	_, err := sig.SetBytes(data)
+	return sig, err
+}
@@ -0,0 +1,198 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLS_GetPublicKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+		publicKey  string
+		address    string
+	}{
+		{
+			name:       "test 1",
+			privateKey: "BLsk1eGhiPQXKtvvkBeXzmtVVJs6KPhEF45drF7MLjoCDcSnTGuyjL",
+			publicKey:  "BLpk1ur5XXicWYMMzCVZZWyLZhybtyX8Zot2uCzDCZW8KcC5BdZiLVXRZvZzi4GuZYL9SarUvKpE",
+			address:    "tz4TFJdv9Jd44FtBMAxi3KQT7AtazhVyaPa6",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewKeyFromBase58(tt.privateKey)
+			require.NoError(t, err)
+
+			pub, err := key.pubKey.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.publicKey, pub)
+
+			address, err := key.Address()
+			require.NoError(t, err)
+			require.Equal(t, tt.address, address)
+		})
+	}
+}
+
+func TestBLS_Sign(t *testing.T) {
+	curve := NewBLS()
+
+	sk, err := encoding.DecodeBase58("BLsk1eGhiPQXKtvvkBeXzmtVVJs6KPhEF45drF7MLjoCDcSnTGuyjL")
+	require.NoError(t, err)
+	pk, err := curve.GetPublicKey(sk)
+	require.NoError(t, err)
+
+	data := []byte("hello")
+	signature, err := curve.Sign(data, sk)
+	require.NoError(t, err)
+	require.Len(t, signature.Bytes(), 96)
+
+	encoded, err := signature.Base58()
+	require.NoError(t, err)
+	require.Equal(t, "BLsig", encoded[:5])
+
+	require.True(t, curve.Verify(data, signature.Bytes(), pk))
+	require.False(t, curve.Verify([]byte("hello!"), signature.Bytes(), pk))
+
+	otherPk, _, err := curve.GeneratePrivateKey()
+	require.NoError(t, err)
+	require.Len(t, otherPk, blsPublicKeySize)
+	require.False(t, curve.Verify(data, signature.Bytes(), otherPk))
+}
+
+func TestBLS_KnownAnswer(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey string
+		data       string
+		signature  string
+		proof      string
+	}{
+		{
+			name:       "hello",
+			privateKey: "BLsk1eGhiPQXKtvvkBeXzmtVVJs6KPhEF45drF7MLjoCDcSnTGuyjL",
+			data:       "68656c6c6f",
+			signature:  "BLsig9dd4pT3jVSqdEG51W1wfeZHmyem6CweaXGz96gVu3BxpJKru58wVNGi5BA4CQ4SnPdB9JxajeemvjQxRBxkC4o3E1APeKNkb3dJW6Sgb8BEzv8VBQEaMMeiSSKBo1XvmViiN4KjvF",
+			proof:      "BLsigBGHyrcpAvoHAxhTji1ydHRDvPyaF9m7zpRrfYDxRmuacpRa4DPE2tdkbmjocAYJsqXsgo2A2WAoYiHufRUXCd9JTGGq8SC1C8WG653BbARCSA5Sb7oPfmxDmgP1AxNPyeZ4NR9muU",
+		}, {
+			name:       "operation",
+			privateKey: "BLsk1eGhiPQXKtvvkBeXzmtVVJs6KPhEF45drF7MLjoCDcSnTGuyjL",
+			data:       "03f265006be7d2f678d4ca8cae9f1877b40a76a21f8b05e73254043af01f3638786c00739ab9281b15479d756572217dc8bb944f2b02a7880ef0e42ac350f403c0843d00008384a29947e81770a1586cb268552a25ee81fb7a00",
+			signature:  "BLsigB7ynPSLKwDUhrZGj3NE8vLALJxkFiV2NZJYzAGG5GnNL8MTCcBuY2xwztjUht4CNJwnt5gpgALqB1UPr8PUvXmvbifC8r5Yohb6upnZmaoGEESijwCFjGGzqNLAfiW4PZsqxpB36W",
+			proof:      "BLsigBGHyrcpAvoHAxhTji1ydHRDvPyaF9m7zpRrfYDxRmuacpRa4DPE2tdkbmjocAYJsqXsgo2A2WAoYiHufRUXCd9JTGGq8SC1C8WG653BbARCSA5Sb7oPfmxDmgP1AxNPyeZ4NR9muU",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curve := NewBLS()
+
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+			sk, err := encoding.DecodeBase58(tt.privateKey)
+			require.NoError(t, err)
+			pk, err := curve.GetPublicKey(sk)
+			require.NoError(t, err)
+
+			signature, err := curve.Sign(data, sk)
+			require.NoError(t, err)
+			encoded, err := signature.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.signature, encoded)
+
+			wantSignature, err := encoding.DecodeBase58(tt.signature)
+			require.NoError(t, err)
+			require.True(t, curve.Verify(data, wantSignature, pk))
+
+			proof, err := curve.ProvePossession(sk)
+			require.NoError(t, err)
+			encoded, err = proof.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.proof, encoded)
+
+			wantProof, err := encoding.DecodeBase58(tt.proof)
+			require.NoError(t, err)
+			require.True(t, curve.VerifyPossession(pk, wantProof))
+		})
+	}
+}
+
+// Hashing to G2, scalar multiplication and point compression are checked with the signing vector of
+// Ethereum consensus specs (BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_ ciphersuite, big-endian private key).
+func TestBLS_ConsensusSpecVector(t *testing.T) {
+	curve := NewBLS()
+
+	privateKey, err := hex.DecodeString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")
+	require.NoError(t, err)
+	slices.Reverse(privateKey)
+
+	pk, err := curve.GetPublicKey(privateKey)
+	require.NoError(t, err)
+	require.Equal(t, "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a", hex.EncodeToString(pk))
+
+	signature, err := curve.sign(make([]byte, 32), privateKey, "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	require.NoError(t, err)
+	require.Equal(t,
+		"b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55",
+		hex.EncodeToString(signature.Bytes()),
+	)
+	require.True(t, curve.verify(make([]byte, 32), signature.Bytes(), pk, "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+}
+
+func TestBLS_ProvePossession(t *testing.T) {
+	key, err := NewKeyFromBase58("BLsk1eGhiPQXKtvvkBeXzmtVVJs6KPhEF45drF7MLjoCDcSnTGuyjL")
+	require.NoError(t, err)
+
+	proof, err := key.ProvePossession()
+	require.NoError(t, err)
+	require.True(t, key.pubKey.VerifyPossession(proof.Bytes()))
+
+	other, err := NewKey(KindBLS)
+	require.NoError(t, err)
+	require.False(t, other.pubKey.VerifyPossession(proof.Bytes()))
+
+	signature, err := key.Sign(key.pubKey.Bytes())
+	require.NoError(t, err)
+	require.False(t, key.pubKey.VerifyPossession(signature.Bytes()), "proof must use its own domain")
+
+	edKey, err := NewKey(KindEd25519)
+	require.NoError(t, err)
+	_, err = edKey.ProvePossession()
+	require.Error(t, err)
+}
+
+func TestBLS_AggregateVerify(t *testing.T) {
+	curve := NewBLS()
+
+	var (
+		data       [][]byte
+		pubKeys    [][]byte
+		signatures [][]byte
+	)
+	for i := range 3 {
+		pk, sk, err := curve.GeneratePrivateKey()
+		require.NoError(t, err)
+
+		msg := []byte{byte(i), 1, 2, 3}
+		signature, err := curve.Sign(msg, sk)
+		require.NoError(t, err)
+
+		data = append(data, msg)
+		pubKeys = append(pubKeys, pk)
+		signatures = append(signatures, signature.Bytes())
+	}
+
+	aggregated, err := curve.AggregateSignatures(signatures...)
+	require.NoError(t, err)
+	require.True(t, curve.AggregateVerify(data, aggregated.Bytes(), pubKeys))
+
+	require.False(t, curve.AggregateVerify(data[:2], aggregated.Bytes(), pubKeys[:2]))
+	require.False(t, curve.AggregateVerify([][]byte{data[1], data[0], data[2]}, aggregated.Bytes(), pubKeys))
+
+	_, err = curve.AggregateSignatures()
+	require.Error(t, err)
+}
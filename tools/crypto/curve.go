@@ -9,6 +9,7 @@ const (
 	KindEd25519 ECKind = iota + 1
 	KindSecp256k1
 	KindNistP256
+	KindBLS
 )
 
 // Curve -
@@ -27,8 +28,12 @@ func NewCurve(kind ECKind) (Curve, error) {
 	switch kind {
 	case KindEd25519:
 		return NewEd25519(), nil
-	// case KindSecp256k1:
-	// case KindNistP256:
+	case KindSecp256k1:
+		return NewSecp256k1(), nil
+	case KindNistP256:
+		return NewNistP256(), nil
+	case KindBLS:
+		return NewBLS(), nil
 	default:
 		return nil, errors.Errorf("unknown curve kind: %d", kind)
 	}
@@ -39,10 +44,12 @@ func NewCurveFromPrefix(prefix string) (Curve, error) {
 	switch prefix {
 	case "edsig", "edsk", "edpk", "tz1", "edesk":
 		return NewEd25519(), nil
-	// case "spsig", "sppk", "spsk", "tz2", "spesk":
-	// 	// Secp256k1
-	// case "p2sig", "p2pk", "p2sk", "tz3", "p2esk":
-	// 	// NistP256
+	case "spsig", "sppk", "spsk", "tz2", "spesk":
+		return NewSecp256k1(), nil
+	case "p2sig", "p2pk", "p2sk", "tz3", "p2esk":
+		return NewNistP256(), nil
+	case "BLsig", "BLpk", "BLsk", "tz4", "BLesk":
+		return NewBLS(), nil
 	default:
 		return nil, errors.Errorf("unknown curve prefix: %s", prefix)
 	}
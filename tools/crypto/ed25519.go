@@ -50,14 +50,20 @@ func (curve Ed25519) GetPublicKey(privateKey []byte) ([]byte, error) {
 	return []byte(pk.(ed25519.PublicKey)), nil
 }
 
-// Sign -
+// Sign - private key can be either 64-byte secret key or 32-byte seed
 func (curve Ed25519) Sign(data []byte, privateKey []byte) (Signature, error) {
-	if ed25519.PrivateKeySize != len(privateKey) {
-		return Signature{}, errors.Errorf("invalid private key length: %d != %d", len(privateKey), ed25519.PrivateKeySize)
+	var sk ed25519.PrivateKey
+	switch len(privateKey) {
+	case ed25519.PrivateKeySize:
+		sk = ed25519.PrivateKey(privateKey)
+	case ed25519.SeedSize:
+		sk = ed25519.NewKeyFromSeed(privateKey)
+	default:
+		return Signature{}, errors.Errorf("invalid private key length: %d", len(privateKey))
 	}
 
 	digest := blake2b.Sum256(data)
-	sign := ed25519.Sign(privateKey, digest[:])
+	sign := ed25519.Sign(sk, digest[:])
 	return NewSignature(sign, curve.signaturePrefix), nil
 }
 
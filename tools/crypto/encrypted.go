@@ -0,0 +1,125 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// key derivation parameters of octez-client keystore
+const (
+	encryptedKeySaltSize   = 8
+	encryptedKeyIterations = 32768
+	encryptedKeyLength     = 32
+)
+
+// errors
+var (
+	ErrInvalidPassphrase = errors.New("invalid passphrase")
+)
+
+// NewKeyFromEncryptedBase58 - decrypts secret key encrypted by passphrase (edesk, spesk, p2esk or BLesk) and returns the key
+func NewKeyFromEncryptedBase58(data string, passphrase []byte) (Key, error) {
+	if len(data) < 5 {
+		return Key{}, errors.Errorf("invalid encrypted key string: %s", data)
+	}
+
+	curve, err := NewCurveFromPrefix(data[:5])
+	if err != nil {
+		return Key{}, err
+	}
+	if data[:5] != encryptedKeyPrefix(curve.Kind()) {
+		return Key{}, errors.Errorf("key is not encrypted: %s", data[:5])
+	}
+
+	encrypted, err := encoding.DecodeBase58(data)
+	if err != nil {
+		return Key{}, err
+	}
+
+	sk, err := decryptKey(encrypted, passphrase)
+	if err != nil {
+		return Key{}, err
+	}
+	return NewKeyFromBytes(sk, curve.Kind())
+}
+
+// EncryptedBase58 - encrypts secret key by passphrase with the same scheme as octez-client (PBKDF2-SHA512 + secretbox). Ed25519 key is encrypted as seed.
+func (key Key) EncryptedBase58(passphrase []byte) (string, error) {
+	sk := key.bytes
+	if key.curve.Kind() == KindEd25519 && len(sk) == ed25519.PrivateKeySize {
+		sk = ed25519.PrivateKey(sk).Seed()
+	}
+
+	encrypted, err := encryptKey(sk, passphrase)
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeBase58(encrypted, []byte(encryptedKeyPrefix(key.curve.Kind())))
+}
+
+func encryptedKeyPrefix(kind ECKind) string {
+	switch kind {
+	case KindEd25519:
+		return encoding.PrefixED25519EncryptedSeed
+	case KindSecp256k1:
+		return encoding.PrefixSecp256k1EncryptedSecretKey
+	case KindNistP256:
+		return encoding.PrefixP256EncryptedSecretKey
+	case KindBLS:
+		return encoding.PrefixBLS12381EncryptedSecretKey
+	default:
+		return ""
+	}
+}
+
+func decryptKey(data, passphrase []byte) ([]byte, error) {
+	if len(data) <= encryptedKeySaltSize+secretbox.Overhead {
+		return nil, errors.Errorf("invalid encrypted key length: %d", len(data))
+	}
+
+	salt := data[:encryptedKeySaltSize]
+	secretKey, err := deriveSecretboxKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	// octez-client uses zero nonce since the key is unique for every salt
+	var nonce [24]byte
+	sk, ok := secretbox.Open(nil, data[encryptedKeySaltSize:], &nonce, secretKey)
+	if !ok {
+		return nil, ErrInvalidPassphrase
+	}
+	return sk, nil
+}
+
+func encryptKey(sk, passphrase []byte) ([]byte, error) {
+	salt := make([]byte, encryptedKeySaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	secretKey, err := deriveSecretboxKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [24]byte
+	return secretbox.Seal(salt, sk, &nonce, secretKey), nil
+}
+
+func deriveSecretboxKey(passphrase, salt []byte) (*[32]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	derived := pbkdf2.Key(passphrase, salt, encryptedKeyIterations, encryptedKeyLength, sha512.New)
+
+	var key [32]byte
+	copy(key[:], derived)
+	return &key, nil
+}
@@ -0,0 +1,84 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyFromEncryptedBase58(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		passphrase string
+		pub        string
+		address    string
+		wantErr    bool
+	}{
+		{
+			name:       "ed25519",
+			data:       "edesk1uiM6BaysskGto8pRtzKQqFqsy1sea1QRjTzaQYuBxYNhuN6eqEU78TGRXZocsVRJYcN7AaU9JDykwUd8KW",
+			passphrase: "foo",
+			pub:        "edpkttVn1coEZNjcjjAF36jDXDB377imNiKCHqjdXSt85eVN779jfX",
+			address:    "tz1MKPxkZLfdw31LL7zi55aZEoyH9DPL7eh7",
+		}, {
+			name:       "secp256k1",
+			data:       "spesk246GnDVaqGoYZvKbjrWM1g6xUXnyETXtwZgEYFnP8BQXcaS4rfQQco7C94D1yBmcL1v46Sqy8fXrhBSM7TW",
+			passphrase: "foo",
+			pub:        "sppk7aSJpAzeXNTaobig65si221WTqgPh8mJsCJSAiZU7asJkWBVGyx",
+			address:    "tz29QkiEM1xf3chaZj6DjL5udNLbUZ8d6QJ4",
+		}, {
+			name:       "p256",
+			data:       "p2esk27ocLPLp1JkTWfxByXysGyB7MBDURYJAzAGJLR3XSEV9Nq8wFFdDVXVTwvCwR7Ne2dcUveamjXbvZf3on6T",
+			passphrase: "foo",
+			pub:        "p2pk66vAYU7rN1ckJMp38Z9pXCrkiZCVyi6KyeMwhY69h5WDPHdMecH",
+			address:    "tz3Qa3kjWa6B3XgvZcVe24gTfjkc5WZRz59Q",
+		}, {
+			name:       "invalid passphrase",
+			data:       "edesk1uiM6BaysskGto8pRtzKQqFqsy1sea1QRjTzaQYuBxYNhuN6eqEU78TGRXZocsVRJYcN7AaU9JDykwUd8KW",
+			passphrase: "bar",
+			wantErr:    true,
+		}, {
+			name:       "not encrypted",
+			data:       "edsk4GGs6oeqasc61QtgmWuQb6Yhkpx5MSva8Euq7bvzVEF3VpHdZR",
+			passphrase: "foo",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKeyFromEncryptedBase58(tt.data, []byte(tt.passphrase))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			pub, err := got.pubKey.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.pub, pub)
+
+			address, err := got.Address()
+			require.NoError(t, err)
+			require.Equal(t, tt.address, address)
+		})
+	}
+}
+
+func TestKey_EncryptedBase58(t *testing.T) {
+	for _, kind := range []ECKind{KindEd25519, KindSecp256k1, KindNistP256, KindBLS} {
+		key, err := NewKey(kind)
+		require.NoError(t, err)
+
+		encrypted, err := key.EncryptedBase58([]byte("secret"))
+		require.NoError(t, err)
+		require.Equal(t, encryptedKeyPrefix(kind), encrypted[:5])
+
+		decrypted, err := NewKeyFromEncryptedBase58(encrypted, []byte("secret"))
+		require.NoError(t, err)
+		require.Equal(t, key.pubKey.Bytes(), decrypted.pubKey.Bytes())
+
+		_, err = NewKeyFromEncryptedBase58(encrypted, []byte("wrong"))
+		require.ErrorIs(t, err, ErrInvalidPassphrase)
+	}
+}
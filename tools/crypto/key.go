@@ -112,7 +112,7 @@ func (key Key) String() string {
 
 // Sign -
 func (key Key) Sign(data []byte) (Signature, error) {
-	return key.curve.Sign(data, append(key.bytes, key.pubKey.bytes...))
+	return key.curve.Sign(data, key.bytes)
 }
 
 // Verify -
@@ -120,7 +120,21 @@ func (key Key) Verify(data, signature []byte) bool {
 	return key.pubKey.Verify(data, signature)
 }
 
+// PublicKey -
+func (key Key) PublicKey() PubKey {
+	return key.pubKey
+}
+
 // Address -
 func (key Key) Address() (string, error) {
 	return key.pubKey.Address()
 }
+
+// ProvePossession - returns proof of possession of the private key. It's required to reveal BLS (tz4) account.
+func (key Key) ProvePossession() (Signature, error) {
+	curve, ok := key.curve.(BLS)
+	if !ok {
+		return Signature{}, errors.Errorf("proof of possession is not supported by curve: %d", key.curve.Kind())
+	}
+	return curve.ProvePossession(key.bytes)
+}
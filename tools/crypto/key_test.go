@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewKeyFromBase58(t *testing.T) {
@@ -29,6 +30,25 @@ func TestNewKeyFromBase58(t *testing.T) {
 			data:    "edsk2zpXnyz3yoFQpVekcZgbgnXbHfSrheLRkxMLNkfVjaCDQaViRa",
 			pub:     "edpkvNbNZBn9PgDP6FYXzDe2fECAQLqaRPkT3SfjyJgHgDZ5ExPw4K",
 			address: "tz1gR5pEVRysV4j7391xCXdbTGtQwxdGhWUY",
+		}, {
+			name:    "test 4",
+			data:    "edsk4FTF78Qf1m2rykGpHqostAiq5gYW4YZEoGUSWBTJr2njsDHSnd",
+			pub:     "edpkv45regue1bWtuHnCgLU8xWKLwa9qRqv4gimgJKro4LSc3C5VjV",
+			address: "tz1LggX2HUdvJ1tF4Fvv8fjsrzLeW4Jr9t2Q",
+		}, {
+			name:    "secp256k1",
+			data:    "spsk2oTAhiaSywh9ctt8yZLRxL3bo8Mayd3hKFi5iBaoqj2R8bx7ow",
+			pub:     "sppk7auhfZa5wAcR8hk3WCw47kHgG3Pp8zaP3ctdAqdDd2dBAeZBof1",
+			address: "tz2VN9n2C56xGLykHCjhNvZQqUeTVisrHjxA",
+		}, {
+			name:    "p256",
+			data:    "p2sk35q9MJHLN1SBHNhKq7oho1vnZL28bYfsSKDUrDn2e4XVcp6ohZ",
+			pub:     "p2pk64zMPtYav6yiaHV2DhSQ65gbKMr3gkLQtK7TTQCpJEVUhxxEnxo",
+			address: "tz3VCJEo1rRyyVejmpaRjbgGT9uE66sZmUtQ",
+		}, {
+			name:    "unknown prefix",
+			data:    "xxsk35q9MJHLN1SBHNhKq7oho1vnZL28bYfsSKDUrDn2e4XVcp6ohZ",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -38,6 +58,9 @@ func TestNewKeyFromBase58(t *testing.T) {
 				t.Errorf("NewKeyFromBase58() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 			address, err := got.pubKey.Address()
 			if err != nil {
 				t.Errorf("got.pubKey.Address() error = %v", err)
@@ -54,3 +77,61 @@ func TestNewKeyFromBase58(t *testing.T) {
 		})
 	}
 }
+
+func TestKey_Sign(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "ed25519 seed",
+			data: "edsk4GGs6oeqasc61QtgmWuQb6Yhkpx5MSva8Euq7bvzVEF3VpHdZR",
+		}, {
+			name: "ed25519 secret key",
+			data: "edskS5TK48f54kxUR6GVPvbqRsnTKnzXfEUZJ62Mo37yHP7YeC6Jy8MW88iKxDZYCc8Go6ZB9NLmNBaRfjm37Jn4dMpQZjoGSB",
+		}, {
+			name: "secp256k1",
+			data: "spsk2oTAhiaSywh9ctt8yZLRxL3bo8Mayd3hKFi5iBaoqj2R8bx7ow",
+		}, {
+			name: "p256",
+			data: "p2sk35q9MJHLN1SBHNhKq7oho1vnZL28bYfsSKDUrDn2e4XVcp6ohZ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewKeyFromBase58(tt.data)
+			require.NoError(t, err)
+
+			data := []byte("hello")
+			signature, err := key.Sign(data)
+			require.NoError(t, err)
+			require.True(t, key.Verify(data, signature.Bytes()))
+			require.False(t, key.Verify([]byte("hello!"), signature.Bytes()))
+
+			pubKey, err := key.pubKey.Base58()
+			require.NoError(t, err)
+			pk, err := NewPubKeyFromBase58(pubKey)
+			require.NoError(t, err)
+			require.True(t, pk.Verify(data, signature.Bytes()))
+		})
+	}
+}
+
+func TestNewKey(t *testing.T) {
+	for _, kind := range []ECKind{KindEd25519, KindSecp256k1, KindNistP256} {
+		key, err := NewKey(kind)
+		require.NoError(t, err)
+
+		restored, err := NewKeyFromBytes(key.Bytes(), kind)
+		require.NoError(t, err)
+		require.Equal(t, key.pubKey.Bytes(), restored.pubKey.Bytes())
+
+		address, err := key.Address()
+		require.NoError(t, err)
+		require.Len(t, address, 36)
+
+		signature, err := key.Sign([]byte("data"))
+		require.NoError(t, err)
+		require.True(t, restored.Verify([]byte("data"), signature.Bytes()))
+	}
+}
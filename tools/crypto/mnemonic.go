@@ -0,0 +1,173 @@
+package crypto
+
+import (
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultDerivationPath - derivation path of the first Tezos account used by wallets
+const DefaultDerivationPath = "m/44'/1729'/0'/0'"
+
+const hardenedKeyOffset uint32 = 0x80000000
+
+// NewMnemonic - generates new BIP39 mnemonic of 24 words
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewKeyFromMnemonic - creates key from BIP39 mnemonic and optional passphrase.
+// If derivation path is empty the key is the first 32 bytes of seed as in fundraiser and faucet accounts.
+// Otherwise the key is derived by SLIP-10 (BIP32 for secp256k1), for example, with path m/44'/1729'/0'/0'.
+func NewKeyFromMnemonic(mnemonic, passphrase, path string, curveKind ECKind) (Key, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return Key{}, errors.Wrap(err, "mnemonic")
+	}
+	return NewKeyFromSeed(seed, path, curveKind)
+}
+
+// NewKeyFromSeed - creates key from BIP39 seed. Derivation path is applied as in `NewKeyFromMnemonic`.
+func NewKeyFromSeed(seed []byte, path string, curveKind ECKind) (Key, error) {
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		return Key{}, err
+	}
+
+	if indexes == nil {
+		if len(seed) < 32 {
+			return Key{}, errors.Errorf("too short seed: %d", len(seed))
+		}
+		return NewKeyFromBytes(seed[:32], curveKind)
+	}
+
+	sk, err := deriveKey(seed, indexes, curveKind)
+	if err != nil {
+		return Key{}, err
+	}
+	return NewKeyFromBytes(sk, curveKind)
+}
+
+func parseDerivationPath(path string) ([]uint32, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(path, "m/"), "/")
+	indexes := make([]uint32, 0, len(parts))
+	for i := range parts {
+		part := parts[i]
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, errors.Errorf("invalid derivation path: %s", path)
+		}
+		if hardened {
+			index += uint64(hardenedKeyOffset)
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, nil
+}
+
+func deriveKey(seed []byte, indexes []uint32, curveKind ECKind) ([]byte, error) {
+	var (
+		seedKey string
+		order   *big.Int
+	)
+	switch curveKind {
+	case KindEd25519:
+		seedKey = "ed25519 seed"
+	case KindSecp256k1:
+		seedKey = "Bitcoin seed"
+		order = secp256k1.S256().N
+	case KindNistP256:
+		seedKey = "Nist256p1 seed"
+		order = elliptic.P256().Params().N
+	default:
+		return nil, errors.Errorf("key derivation is not supported by curve: %d", curveKind)
+	}
+
+	curve, err := NewCurve(curveKind)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := hmacSHA512([]byte(seedKey), seed)
+	for order != nil && !isValidScalar(digest[:32], order) {
+		digest = hmacSHA512([]byte(seedKey), digest)
+	}
+	key, chainCode := digest[:32], digest[32:]
+
+	for _, index := range indexes {
+		hardened := index >= hardenedKeyOffset
+		if order == nil && !hardened {
+			return nil, errors.Errorf("ed25519 supports only hardened derivation: %d", index)
+		}
+
+		data := make([]byte, 0, 37)
+		if hardened {
+			data = append(data, 0)
+			data = append(data, key...)
+		} else {
+			pk, err := curve.GetPublicKey(key)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, pk...)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		digest = hmacSHA512(chainCode, data)
+		if order == nil {
+			key, chainCode = digest[:32], digest[32:]
+			continue
+		}
+
+		for {
+			child := new(big.Int).SetBytes(digest[:32])
+			if child.Cmp(order) < 0 {
+				child.Add(child, new(big.Int).SetBytes(key))
+				child.Mod(child, order)
+				if child.Sign() != 0 {
+					key = child.FillBytes(make([]byte, 32))
+					chainCode = digest[32:]
+					break
+				}
+			}
+			// SLIP-10: retry with the next candidate if the child key is invalid
+			retry := append([]byte{1}, digest[32:]...)
+			digest = hmacSHA512(chainCode, binary.BigEndian.AppendUint32(retry, index))
+		}
+	}
+	return key, nil
+}
+
+func isValidScalar(data []byte, order *big.Int) bool {
+	scalar := new(big.Int).SetBytes(data)
+	return scalar.Sign() != 0 && scalar.Cmp(order) < 0
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
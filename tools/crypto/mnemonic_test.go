@@ -0,0 +1,157 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyFromSeed(t *testing.T) {
+	// SLIP-10 test vector 1
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		path    string
+		kind    ECKind
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "ed25519 m/0'",
+			path: "m/0'",
+			kind: KindEd25519,
+			want: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		}, {
+			name: "ed25519 m/0'/1'",
+			path: "m/0H/1H",
+			kind: KindEd25519,
+			want: "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		}, {
+			name:    "ed25519 non-hardened",
+			path:    "m/0'/1",
+			kind:    KindEd25519,
+			wantErr: true,
+		}, {
+			name: "secp256k1 m/0'",
+			path: "m/0'",
+			kind: KindSecp256k1,
+			want: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		}, {
+			name: "secp256k1 m/0'/1",
+			path: "m/0'/1",
+			kind: KindSecp256k1,
+			want: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		}, {
+			name: "p256 m/0'",
+			path: "m/0'",
+			kind: KindNistP256,
+			want: "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c",
+		}, {
+			name: "p256 m/0'/1",
+			path: "m/0'/1",
+			kind: KindNistP256,
+			want: "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129",
+		}, {
+			name:    "bls",
+			path:    "m/0'",
+			kind:    KindBLS,
+			wantErr: true,
+		}, {
+			name:    "invalid path",
+			path:    "m/a'",
+			kind:    KindEd25519,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKeyFromSeed(seed, tt.path, tt.kind)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Hex())
+		})
+	}
+}
+
+func TestNewKeyFromMnemonic(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	key, err := NewKeyFromMnemonic(mnemonic, "TREZOR", "", KindEd25519)
+	require.NoError(t, err)
+	require.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5349553", key.Hex())
+
+	_, err = NewKeyFromMnemonic("abandon abandon abandon", "", "", KindEd25519)
+	require.Error(t, err)
+
+	generated, err := NewMnemonic()
+	require.NoError(t, err)
+	for _, kind := range []ECKind{KindEd25519, KindSecp256k1, KindNistP256} {
+		_, err := NewKeyFromMnemonic(generated, "", DefaultDerivationPath, kind)
+		require.NoError(t, err)
+	}
+}
+
+func TestNewKeyFromMnemonic_DefaultDerivationPath(t *testing.T) {
+	// keys and addresses are computed by an independent SLIP-10 implementation (hmac-sha512, RFC 8032, SEC 1)
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	tests := []struct {
+		name       string
+		passphrase string
+		kind       ECKind
+		wantKey    string
+		wantAddr   string
+	}{
+		{
+			name:     "ed25519",
+			kind:     KindEd25519,
+			wantKey:  "c62dc125754854b804d4d40b3559bc239e5bacf0da85e2f25e9970b0be1f8705",
+			wantAddr: "tz1VQA4RP4fLjEEMW2FR4pE9kAg5abb5h5GL",
+		}, {
+			name:     "secp256k1",
+			kind:     KindSecp256k1,
+			wantKey:  "324249b7db6ba1c2ae50c0f37fa52d1dd3a34539428f7ba6aa678f1d0876b508",
+			wantAddr: "tz2V8sWp1WJGnFuWmCpcLVuGknMNWXE95bf4",
+		}, {
+			name:     "p256",
+			kind:     KindNistP256,
+			wantKey:  "b1ce996948ddbf50aa73e37bcfb4bdb60996443fd1cda918daa7d99149b073ec",
+			wantAddr: "tz3Z2ieN3G57rFR8G24pnL2u4FPisJhHxVrt",
+		}, {
+			name:       "ed25519 with passphrase",
+			passphrase: "TREZOR",
+			kind:       KindEd25519,
+			wantKey:    "dc4cbf6aa61ef8bc06916378071971dc4e1b97cf3d93338e45f3ff064cfcab28",
+			wantAddr:   "tz1Kg69Kr1THHqzupNnsrLZBMXqceYyNYmYh",
+		}, {
+			name:       "secp256k1 with passphrase",
+			passphrase: "TREZOR",
+			kind:       KindSecp256k1,
+			wantKey:    "b3574a3256a55c609a18982615ca2ffe161d36bf8e6b9d374d3294e91e514b2c",
+			wantAddr:   "tz2Nn7TkH4Dz6oD9wSXVjbgR99dayqwFQATw",
+		}, {
+			name:       "p256 with passphrase",
+			passphrase: "TREZOR",
+			kind:       KindNistP256,
+			wantKey:    "b20e961b7696e30087b89772626882d0e1340c4e5d7ee4c48db67b9e88e30433",
+			wantAddr:   "tz3bmhhwFnJ7TzN3tvLFkhSdLbiSLhLknmio",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewKeyFromMnemonic(mnemonic, tt.passphrase, DefaultDerivationPath, tt.kind)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantKey, key.Hex())
+
+			address, err := key.Address()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAddr, address)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	nistP256PrivateKeySize = 32
+	nistP256PublicKeySize  = 33
+	nistP256SignatureSize  = 64
+)
+
+// NistP256 -
+type NistP256 struct {
+	addressPrefix    []byte
+	publicKeyPrefix  []byte
+	privateKeyPrefix []byte
+	signaturePrefix  []byte
+
+	curve     elliptic.Curve
+	halfOrder *big.Int
+}
+
+// NewNistP256 -
+func NewNistP256() NistP256 {
+	curve := elliptic.P256()
+	return NistP256{
+		addressPrefix:    []byte(encoding.PrefixPublicKeyTZ3),
+		publicKeyPrefix:  []byte(encoding.PrefixP256PublicKey),
+		privateKeyPrefix: []byte(encoding.PrefixP256SecretKey),
+		signaturePrefix:  []byte(encoding.PrefixP256Signature),
+		curve:            curve,
+		halfOrder:        new(big.Int).Rsh(curve.Params().N, 1),
+	}
+}
+
+// GeneratePrivateKey -
+func (curve NistP256) GeneratePrivateKey() ([]byte, []byte, error) {
+	sk, err := ecdsa.GenerateKey(curve.curve, rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	skBytes, err := sk.Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	pkBytes, err := curve.compressPublicKey(&sk.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pkBytes, skBytes, nil
+}
+
+// GetPublicKey -
+func (curve NistP256) GetPublicKey(privateKey []byte) ([]byte, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return curve.compressPublicKey(&sk.PublicKey)
+}
+
+// Sign - signs blake2b digest of data. Signature is deterministic (RFC 6979) and has low S.
+func (curve NistP256) Sign(data []byte, privateKey []byte) (Signature, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	digest := blake2b.Sum256(data)
+	result, err := curve.signDigest(sk, digest[:])
+	if err != nil {
+		return Signature{}, err
+	}
+	return NewSignature(result, curve.signaturePrefix), nil
+}
+
+func (curve NistP256) signDigest(sk *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	// SHA256 is used only as HMAC-DRBG hash function of RFC 6979 nonce generation
+	der, err := sk.Sign(nil, digest, crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, errors.Wrap(err, "signature decoding")
+	}
+	if sig.S.Cmp(curve.halfOrder) > 0 {
+		sig.S.Sub(curve.curve.Params().N, sig.S)
+	}
+
+	result := make([]byte, nistP256SignatureSize)
+	sig.R.FillBytes(result[:32])
+	sig.S.FillBytes(result[32:])
+	return result, nil
+}
+
+// Verify -
+func (curve NistP256) Verify(data []byte, signature []byte, pubKey []byte) bool {
+	if nistP256PublicKeySize != len(pubKey) || nistP256SignatureSize != len(signature) {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve.curve, pubKey)
+	if x == nil {
+		return false
+	}
+	uncompressed := make([]byte, 65)
+	uncompressed[0] = 4
+	x.FillBytes(uncompressed[1:33])
+	y.FillBytes(uncompressed[33:])
+	pk, err := ecdsa.ParseUncompressedPublicKey(curve.curve, uncompressed)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+
+	digest := blake2b.Sum256(data)
+	return ecdsa.Verify(pk, digest[:], r, s)
+}
+
+// AddressPrefix -
+func (curve NistP256) AddressPrefix() []byte {
+	return curve.addressPrefix
+}
+
+// PublicKeyPrefix -
+func (curve NistP256) PublicKeyPrefix() []byte {
+	return curve.publicKeyPrefix
+}
+
+// Kind -
+func (curve NistP256) Kind() ECKind {
+	return KindNistP256
+}
+
+func (curve NistP256) parsePrivateKey(privateKey []byte) (*ecdsa.PrivateKey, error) {
+	if nistP256PrivateKeySize != len(privateKey) {
+		return nil, errors.Errorf("invalid private key length: %d != %d", len(privateKey), nistP256PrivateKeySize)
+	}
+	return ecdsa.ParseRawPrivateKey(curve.curve, privateKey)
+}
+
+func (curve NistP256) compressPublicKey(pk *ecdsa.PublicKey) ([]byte, error) {
+	uncompressed, err := pk.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	compressed := make([]byte, nistP256PublicKeySize)
+	compressed[0] = 2 | uncompressed[64]&1
+	copy(compressed[1:], uncompressed[1:33])
+	return compressed, nil
+}
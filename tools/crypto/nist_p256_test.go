@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNistP256_Sign(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		privateKey string
+		publicKey  string
+		signature  string
+	}{
+		{
+			name:       "test 1",
+			data:       "68656c6c6f",
+			privateKey: "p2sk35q9MJHLN1SBHNhKq7oho1vnZL28bYfsSKDUrDn2e4XVcp6ohZ",
+			publicKey:  "p2pk64zMPtYav6yiaHV2DhSQ65gbKMr3gkLQtK7TTQCpJEVUhxxEnxo",
+			signature:  "p2sigpQ5MAxLjrtLM7Ks5QXNLqXDC4Q3w6YdJjpHrmovFD6YzkV8jnmvvZX4BBguMfdp8vRvPhgGZrJvfJYQV5XznYb1UYGQHm",
+		}, {
+			name:       "test 2",
+			data:       "03f265006be7d2f678d4ca8cae9f1877b40a76a21f8b05e73254043af01f3638786c00739ab9281b15479d756572217dc8bb944f2b02a7880ef0e42ac350f403c0843d00008384a29947e81770a1586cb268552a25ee81fb7a00",
+			privateKey: "p2sk35q9MJHLN1SBHNhKq7oho1vnZL28bYfsSKDUrDn2e4XVcp6ohZ",
+			publicKey:  "p2pk64zMPtYav6yiaHV2DhSQ65gbKMr3gkLQtK7TTQCpJEVUhxxEnxo",
+			signature:  "p2sigtN95vwb95uQN9u8MmBMm6Urkq8tEmczdrSZxrfmXGtWMbmkPU13EP8aBhA6PRhNXQdCG6s74kjfjxNpVwAYHh4YL7eJ4q",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curve := NewNistP256()
+
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+
+			sk, err := encoding.DecodeBase58(tt.privateKey)
+			require.NoError(t, err)
+
+			pk, err := curve.GetPublicKey(sk)
+			require.NoError(t, err)
+
+			wantPk, err := encoding.DecodeBase58(tt.publicKey)
+			require.NoError(t, err)
+			require.Equal(t, wantPk, pk)
+
+			got, err := curve.Sign(data, sk)
+			require.NoError(t, err)
+			require.Len(t, got.Bytes(), 64)
+
+			s := new(big.Int).SetBytes(got.Bytes()[32:])
+			require.LessOrEqual(t, s.Cmp(curve.halfOrder), 0, "signature is not normalized")
+
+			signature, err := got.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.signature, signature)
+
+			require.True(t, curve.Verify(data, got.Bytes(), pk))
+			require.False(t, curve.Verify(append(data, 1), got.Bytes(), pk))
+		})
+	}
+}
+
+// Known answer tests from RFC 6979 A.2.5 (P-256 with SHA-256). S of "sample" signature is normalized to low S.
+func TestNistP256_SignDigest(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		signature string
+	}{
+		{
+			name:      "sample",
+			message:   "sample",
+			signature: "efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf37160834e36ad29a83bf2bc9385e491d6099c8fdf9d1ed67aa7ea5f51f93782857a9",
+		}, {
+			name:      "test",
+			message:   "test",
+			signature: "f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083",
+		},
+	}
+
+	curve := NewNistP256()
+	privateKey, err := hex.DecodeString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	require.NoError(t, err)
+
+	pk, err := curve.GetPublicKey(privateKey)
+	require.NoError(t, err)
+	require.Equal(t, "0360fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6", hex.EncodeToString(pk))
+
+	sk, err := curve.parsePrivateKey(privateKey)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest := sha256.Sum256([]byte(tt.message))
+			got, err := curve.signDigest(sk, digest[:])
+			require.NoError(t, err)
+			require.Equal(t, tt.signature, hex.EncodeToString(got))
+		})
+	}
+}
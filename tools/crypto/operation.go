@@ -0,0 +1,41 @@
+package crypto
+
+import (
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+)
+
+// signature sizes
+const (
+	SignatureSize    = 64
+	BLSSignatureSize = 96
+)
+
+// OperationHash - computes hash (o...) of operation group from forged bytes and signature. If `forged` already contains signature pass nil as signature.
+func OperationHash(forged, signature []byte) (string, error) {
+	data := make([]byte, 0, len(forged)+len(signature))
+	data = append(data, forged...)
+	data = append(data, signature...)
+	return encoding.EncodeBase58(Blake2b256(data), []byte(encoding.PrefixOperationHash))
+}
+
+// VerifyOperation - checks that forged operation group was signed by the public key. Generic operation watermark is prepended to forged bytes.
+func VerifyOperation(pubKey PubKey, forged, signature []byte) bool {
+	if pubKey.curve == nil {
+		return false
+	}
+	data := append([]byte{WatermarkGenericOperation}, forged...)
+	return pubKey.Verify(data, signature)
+}
+
+// VerifySignedOperation - splits signed operation group into forged bytes and signature and verifies it. Signature of tz4 keys is 96 bytes long, 64 bytes for others.
+func VerifySignedOperation(pubKey PubKey, signed []byte) (bool, error) {
+	if pubKey.curve == nil {
+		return false, errors.New("empty public key")
+	}
+	size := pubKey.SignatureSize()
+	if len(signed) <= size {
+		return false, errors.Errorf("signed operation is too short: %d", len(signed))
+	}
+	return VerifyOperation(pubKey, signed[:len(signed)-size], signed[len(signed)-size:]), nil
+}
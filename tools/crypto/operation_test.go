@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const signedTransaction = "5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af7506c00739ab9281b15479d756572217dc8bb944f2b02a7df02828018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce380043ad9eec957817346dcc549139c12159d4fb4f877ce35aa7f8c9fffcba5288b136b882eadc9c7d02d93ad5dec7ba515af315309fd3d62d8aa837e3db278f2e01"
+
+func TestOperationHash(t *testing.T) {
+	signed, err := hex.DecodeString(signedTransaction)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		forged    []byte
+		signature []byte
+		want      string
+	}{
+		{
+			name:      "separate signature",
+			forged:    signed[:len(signed)-SignatureSize],
+			signature: signed[len(signed)-SignatureSize:],
+			want:      "ooJ3LBJbft7ouZN1VrE5cYEc81xSYeCPbtUSJfntUdtoePTsXAD",
+		}, {
+			name:   "signed bytes",
+			forged: signed,
+			want:   "ooJ3LBJbft7ouZN1VrE5cYEc81xSYeCPbtUSJfntUdtoePTsXAD",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OperationHash(tt.forged, tt.signature)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifySignedOperation(t *testing.T) {
+	signed, err := hex.DecodeString(signedTransaction)
+	require.NoError(t, err)
+
+	tampered := append([]byte{}, signed...)
+	tampered[40] ^= 0x01
+
+	blsKey, err := NewKey(KindBLS)
+	require.NoError(t, err)
+	blsForged := signed[:len(signed)-SignatureSize]
+	blsSignature, err := blsKey.Sign(append([]byte{WatermarkGenericOperation}, blsForged...))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pubKey  string
+		key     *Key
+		signed  []byte
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			pubKey: "edpkug9swHbjB2cZtzppehjD7fTYfX1gELoPK6u67nLq19Cm7rKvLm",
+			signed: signed,
+			want:   true,
+		}, {
+			name:   "tampered",
+			pubKey: "edpkug9swHbjB2cZtzppehjD7fTYfX1gELoPK6u67nLq19Cm7rKvLm",
+			signed: tampered,
+			want:   false,
+		}, {
+			name:   "another key",
+			pubKey: "edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ",
+			signed: signed,
+			want:   false,
+		}, {
+			name:   "bls",
+			key:    &blsKey,
+			signed: append(append([]byte{}, blsForged...), blsSignature.Bytes()...),
+			want:   true,
+		}, {
+			name:    "too short",
+			pubKey:  "edpkug9swHbjB2cZtzppehjD7fTYfX1gELoPK6u67nLq19Cm7rKvLm",
+			signed:  signed[:SignatureSize],
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pubKey PubKey
+			if tt.key != nil {
+				pubKey = tt.key.PublicKey()
+			} else {
+				pubKey, err = NewPubKeyFromBase58(tt.pubKey)
+				require.NoError(t, err)
+			}
+
+			got, err := VerifySignedOperation(pubKey, tt.signed)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
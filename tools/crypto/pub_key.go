@@ -89,3 +89,20 @@ func (pk PubKey) String() string {
 func (pk PubKey) Verify(data, signature []byte) bool {
 	return pk.curve.Verify(data, signature, pk.bytes)
 }
+
+// SignatureSize - returns size of signatures made by the key: 96 bytes for BLS (tz4) keys and 64 bytes for others
+func (pk PubKey) SignatureSize() int {
+	if pk.curve != nil && pk.curve.Kind() == KindBLS {
+		return BLSSignatureSize
+	}
+	return SignatureSize
+}
+
+// VerifyPossession - verifies proof of possession of BLS (tz4) public key
+func (pk PubKey) VerifyPossession(proof []byte) bool {
+	curve, ok := pk.curve.(BLS)
+	if !ok {
+		return false
+	}
+	return curve.VerifyPossession(pk.bytes, proof)
+}
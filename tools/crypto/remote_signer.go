@@ -0,0 +1,182 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// RemoteSigner - client of remote signer protocol implemented by octez-signer, Signatory and others
+type RemoteSigner struct {
+	baseURL string
+	client  *http.Client
+	auth    *Key
+}
+
+// RemoteSignerOption -
+type RemoteSignerOption func(*RemoteSigner)
+
+// WithHTTPClient - sets custom HTTP client
+func WithHTTPClient(client *http.Client) RemoteSignerOption {
+	return func(rs *RemoteSigner) {
+		if client != nil {
+			rs.client = client
+		}
+	}
+}
+
+// WithAuthenticationKey - sets key which signs requests if signer requires authentication (see `AuthorizedKeys`)
+func WithAuthenticationKey(key Key) RemoteSignerOption {
+	return func(rs *RemoteSigner) {
+		rs.auth = &key
+	}
+}
+
+// NewRemoteSigner -
+func NewRemoteSigner(baseURL string, opts ...RemoteSignerOption) *RemoteSigner {
+	rs := &RemoteSigner{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client: &http.Client{
+			Timeout: time.Minute,
+		},
+	}
+	for i := range opts {
+		opts[i](rs)
+	}
+	return rs
+}
+
+// PublicKey - receives public key of address: GET /keys/<pkh>
+func (rs *RemoteSigner) PublicKey(ctx context.Context, address string) (PubKey, error) {
+	var response struct {
+		PublicKey string `json:"public_key"`
+	}
+	if err := rs.do(ctx, http.MethodGet, "/keys/"+address, nil, nil, &response); err != nil {
+		return PubKey{}, err
+	}
+	return NewPubKeyFromBase58(response.PublicKey)
+}
+
+// Sign - signs data by the key of address: POST /keys/<pkh>. Data must be prefixed with magic byte.
+func (rs *RemoteSigner) Sign(ctx context.Context, address string, data []byte) (Signature, error) {
+	var query url.Values
+	if rs.auth != nil {
+		authentication, err := rs.authenticate(address, data)
+		if err != nil {
+			return Signature{}, errors.Wrap(err, "authentication")
+		}
+		query = url.Values{"authentication": []string{authentication}}
+	}
+
+	body, err := json.Marshal(hex.EncodeToString(data))
+	if err != nil {
+		return Signature{}, err
+	}
+
+	var response struct {
+		Signature string `json:"signature"`
+	}
+	if err := rs.do(ctx, http.MethodPost, "/keys/"+address, query, body, &response); err != nil {
+		return Signature{}, err
+	}
+	return NewSignatureFromBase58(response.Signature)
+}
+
+// AuthorizedKeys - returns list of public key hashes which are allowed to sign requests. Empty list means signer doesn't require authentication.
+func (rs *RemoteSigner) AuthorizedKeys(ctx context.Context) ([]string, error) {
+	var response struct {
+		AuthorizedKeys []string `json:"authorized_keys"`
+	}
+	if err := rs.do(ctx, http.MethodGet, "/authorized_keys", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.AuthorizedKeys, nil
+}
+
+func (rs *RemoteSigner) authenticate(address string, data []byte) (string, error) {
+	pkh, err := publicKeyHashBytes(address)
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 0, len(pkh)+len(data)+1)
+	msg = append(msg, WatermarkRemoteSignerAuthorization)
+	msg = append(msg, pkh...)
+	msg = append(msg, data...)
+
+	signature, err := rs.auth.Sign(msg)
+	if err != nil {
+		return "", err
+	}
+	return signature.Base58()
+}
+
+func (rs *RemoteSigner) do(ctx context.Context, method, uri string, query url.Values, body []byte, output any) error {
+	link := rs.baseURL + uri
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, link, reader)
+	if err != nil {
+		return errors.Wrap(err, "remote signer request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "remote signer request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("remote signer: %s %s: %d %s", method, uri, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.NewDecoder(resp.Body).Decode(output)
+}
+
+// publicKeyHashBytes - binary representation of implicit address: curve tag followed by 20-byte hash
+func publicKeyHashBytes(address string) ([]byte, error) {
+	if len(address) < 3 {
+		return nil, errors.Errorf("invalid address: %s", address)
+	}
+
+	var tag byte
+	switch address[:3] {
+	case encoding.PrefixPublicKeyTZ1:
+		tag = 0
+	case encoding.PrefixPublicKeyTZ2:
+		tag = 1
+	case encoding.PrefixPublicKeyTZ3:
+		tag = 2
+	case encoding.PrefixPublicKeyTZ4:
+		tag = 3
+	default:
+		return nil, errors.Errorf("address is not implicit: %s", address)
+	}
+
+	hash, err := encoding.DecodeBase58(address)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{tag}, hash...), nil
+}
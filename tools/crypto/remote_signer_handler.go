@@ -0,0 +1,133 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"net/http"
+)
+
+// RemoteSignerHandler - HTTP handler serving remote signer protocol on top of any `Signer`.
+// It is useful as local stand-in of remote signer in tests (for example, with `httptest.NewServer`).
+type RemoteSignerHandler struct {
+	signer         Signer
+	authorizedKeys map[string]PubKey
+	mux            *http.ServeMux
+}
+
+// NewRemoteSignerHandler - creates handler. If authorized keys are passed, sign requests have to be authenticated by one of them.
+func NewRemoteSignerHandler(signer Signer, authorizedKeys ...PubKey) (*RemoteSignerHandler, error) {
+	handler := &RemoteSignerHandler{
+		signer:         signer,
+		authorizedKeys: make(map[string]PubKey),
+		mux:            http.NewServeMux(),
+	}
+	for i := range authorizedKeys {
+		address, err := authorizedKeys[i].Address()
+		if err != nil {
+			return nil, err
+		}
+		handler.authorizedKeys[address] = authorizedKeys[i]
+	}
+
+	handler.mux.HandleFunc("GET /keys/{address}", handler.publicKey)
+	handler.mux.HandleFunc("POST /keys/{address}", handler.sign)
+	handler.mux.HandleFunc("GET /authorized_keys", handler.authorized)
+	return handler, nil
+}
+
+// ServeHTTP -
+func (handler *RemoteSignerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler.mux.ServeHTTP(w, r)
+}
+
+func (handler *RemoteSignerHandler) publicKey(w http.ResponseWriter, r *http.Request) {
+	pk, err := handler.signer.PublicKey(r.Context(), r.PathValue("address"))
+	if err != nil {
+		writeSignerError(w, http.StatusNotFound, err)
+		return
+	}
+	encoded, err := pk.Base58()
+	if err != nil {
+		writeSignerError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeSignerResponse(w, map[string]string{"public_key": encoded})
+}
+
+func (handler *RemoteSignerHandler) sign(w http.ResponseWriter, r *http.Request) {
+	address := r.PathValue("address")
+
+	var body string
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeSignerError(w, http.StatusBadRequest, err)
+		return
+	}
+	data, err := hex.DecodeString(body)
+	if err != nil {
+		writeSignerError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(handler.authorizedKeys) > 0 && !handler.isAuthorized(address, data, r.URL.Query().Get("authentication")) {
+		writeSignerError(w, http.StatusUnauthorized, ErrUnknownSignerKey)
+		return
+	}
+
+	signature, err := handler.signer.Sign(r.Context(), address, data)
+	if err != nil {
+		writeSignerError(w, http.StatusNotFound, err)
+		return
+	}
+	encoded, err := signature.Base58()
+	if err != nil {
+		writeSignerError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeSignerResponse(w, map[string]string{"signature": encoded})
+}
+
+func (handler *RemoteSignerHandler) authorized(w http.ResponseWriter, r *http.Request) {
+	if len(handler.authorizedKeys) == 0 {
+		writeSignerResponse(w, struct{}{})
+		return
+	}
+
+	keys := make([]string, 0, len(handler.authorizedKeys))
+	for address := range handler.authorizedKeys {
+		keys = append(keys, address)
+	}
+	writeSignerResponse(w, map[string][]string{"authorized_keys": keys})
+}
+
+func (handler *RemoteSignerHandler) isAuthorized(address string, data []byte, authentication string) bool {
+	if authentication == "" {
+		return false
+	}
+	signature, err := NewSignatureFromBase58(authentication)
+	if err != nil {
+		return false
+	}
+	pkh, err := publicKeyHashBytes(address)
+	if err != nil {
+		return false
+	}
+
+	msg := append([]byte{WatermarkRemoteSignerAuthorization}, pkh...)
+	msg = append(msg, data...)
+	for _, pk := range handler.authorizedKeys {
+		if pk.Verify(msg, signature.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeSignerResponse(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func writeSignerError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode([]map[string]string{{"kind": "generic", "error": err.Error()}})
+}
@@ -0,0 +1,126 @@
+package crypto
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRemoteSigner(t *testing.T, authorizedKeys ...PubKey) (*httptest.Server, Key) {
+	t.Helper()
+
+	key, err := NewKeyFromBase58("edsk4GGs6oeqasc61QtgmWuQb6Yhkpx5MSva8Euq7bvzVEF3VpHdZR")
+	require.NoError(t, err)
+	memory, err := NewMemorySigner(key)
+	require.NoError(t, err)
+	handler, err := NewRemoteSignerHandler(memory, authorizedKeys...)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server, key
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	server, key := newTestRemoteSigner(t)
+	signer := NewRemoteSigner(server.URL, WithHTTPClient(server.Client()))
+
+	address, err := key.Address()
+	require.NoError(t, err)
+
+	pk, err := signer.PublicKey(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, key.pubKey.Bytes(), pk.Bytes())
+
+	data := []byte{WatermarkGenericOperation, 1, 2, 3}
+	signature, err := signer.Sign(ctx, address, data)
+	require.NoError(t, err)
+	require.True(t, pk.Verify(data, signature.Bytes()))
+
+	want, err := key.Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, want.Bytes(), signature.Bytes())
+
+	keys, err := signer.AuthorizedKeys(ctx)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = signer.PublicKey(ctx, "tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq")
+	require.Error(t, err)
+	_, err = signer.Sign(ctx, "tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq", data)
+	require.Error(t, err)
+}
+
+func TestRemoteSigner_Authentication(t *testing.T) {
+	ctx := context.Background()
+
+	auth, err := NewKey(KindSecp256k1)
+	require.NoError(t, err)
+	server, key := newTestRemoteSigner(t, auth.pubKey)
+
+	address, err := key.Address()
+	require.NoError(t, err)
+	authAddress, err := auth.Address()
+	require.NoError(t, err)
+
+	data := []byte{WatermarkGenericOperation, 4, 5, 6}
+
+	unauthorized := NewRemoteSigner(server.URL, WithHTTPClient(server.Client()))
+	keys, err := unauthorized.AuthorizedKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{authAddress}, keys)
+
+	_, err = unauthorized.Sign(ctx, address, data)
+	require.Error(t, err)
+
+	other, err := NewKey(KindEd25519)
+	require.NoError(t, err)
+	_, err = NewRemoteSigner(server.URL, WithAuthenticationKey(other)).Sign(ctx, address, data)
+	require.Error(t, err)
+
+	signature, err := NewRemoteSigner(server.URL, WithAuthenticationKey(auth)).Sign(ctx, address, data)
+	require.NoError(t, err)
+	require.True(t, key.Verify(data, signature.Bytes()))
+}
+
+func TestMemorySigner(t *testing.T) {
+	ctx := context.Background()
+	key, err := NewKey(KindNistP256)
+	require.NoError(t, err)
+
+	signer, err := NewMemorySigner()
+	require.NoError(t, err)
+	require.NoError(t, signer.Add(key))
+
+	address, err := key.Address()
+	require.NoError(t, err)
+	require.Equal(t, []string{address}, signer.Addresses())
+
+	data := []byte{WatermarkGenericOperation, 7}
+	signature, err := signer.Sign(ctx, address, data)
+	require.NoError(t, err)
+	require.True(t, key.Verify(data, signature.Bytes()))
+
+	_, err = signer.PublicKey(ctx, "tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq")
+	require.ErrorIs(t, err, ErrUnknownSignerKey)
+}
+
+func TestNewSignatureFromBase58(t *testing.T) {
+	key, err := NewKey(KindEd25519)
+	require.NoError(t, err)
+	signature, err := key.Sign([]byte("data"))
+	require.NoError(t, err)
+
+	encoded, err := signature.Base58()
+	require.NoError(t, err)
+
+	decoded, err := NewSignatureFromBase58(encoded)
+	require.NoError(t, err)
+	require.Equal(t, signature, decoded)
+
+	_, err = NewSignatureFromBase58("xxsig")
+	require.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package crypto
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	secp256k1PrivateKeySize = 32
+	secp256k1PublicKeySize  = 33
+	secp256k1SignatureSize  = 64
+)
+
+// Secp256k1 -
+type Secp256k1 struct {
+	addressPrefix    []byte
+	publicKeyPrefix  []byte
+	privateKeyPrefix []byte
+	signaturePrefix  []byte
+}
+
+// NewSecp256k1 -
+func NewSecp256k1() Secp256k1 {
+	return Secp256k1{
+		addressPrefix:    []byte(encoding.PrefixPublicKeyTZ2),
+		publicKeyPrefix:  []byte(encoding.PrefixSecp256k1PublicKey),
+		privateKeyPrefix: []byte(encoding.PrefixSecp256k1SecretKey),
+		signaturePrefix:  []byte(encoding.PrefixSecp256k1Signature),
+	}
+}
+
+// GeneratePrivateKey -
+func (curve Secp256k1) GeneratePrivateKey() ([]byte, []byte, error) {
+	sk, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	return sk.PubKey().SerializeCompressed(), sk.Serialize(), nil
+}
+
+// GetPublicKey -
+func (curve Secp256k1) GetPublicKey(privateKey []byte) ([]byte, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return sk.PubKey().SerializeCompressed(), nil
+}
+
+// Sign - signs blake2b digest of data. Signature is deterministic (RFC 6979) and has low S.
+func (curve Secp256k1) Sign(data []byte, privateKey []byte) (Signature, error) {
+	sk, err := curve.parsePrivateKey(privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	digest := blake2b.Sum256(data)
+	return NewSignature(curve.signDigest(sk, digest[:]), curve.signaturePrefix), nil
+}
+
+func (curve Secp256k1) signDigest(sk *secp256k1.PrivateKey, digest []byte) []byte {
+	sig := ecdsa.Sign(sk, digest)
+
+	r := sig.R()
+	s := sig.S()
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+
+	result := make([]byte, secp256k1SignatureSize)
+	r.PutBytesUnchecked(result[:32])
+	s.PutBytesUnchecked(result[32:])
+	return result
+}
+
+// Verify -
+func (curve Secp256k1) Verify(data []byte, signature []byte, pubKey []byte) bool {
+	if secp256k1PublicKeySize != len(pubKey) || secp256k1SignatureSize != len(signature) {
+		return false
+	}
+
+	pk, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(signature[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+
+	digest := blake2b.Sum256(data)
+	return ecdsa.NewSignature(&r, &s).Verify(digest[:], pk)
+}
+
+// AddressPrefix -
+func (curve Secp256k1) AddressPrefix() []byte {
+	return curve.addressPrefix
+}
+
+// PublicKeyPrefix -
+func (curve Secp256k1) PublicKeyPrefix() []byte {
+	return curve.publicKeyPrefix
+}
+
+// Kind -
+func (curve Secp256k1) Kind() ECKind {
+	return KindSecp256k1
+}
+
+func (curve Secp256k1) parsePrivateKey(privateKey []byte) (*secp256k1.PrivateKey, error) {
+	if secp256k1PrivateKeySize != len(privateKey) {
+		return nil, errors.Errorf("invalid private key length: %d != %d", len(privateKey), secp256k1PrivateKeySize)
+	}
+
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(privateKey); overflow || scalar.IsZero() {
+		return nil, errors.New("invalid secp256k1 private key")
+	}
+	return secp256k1.NewPrivateKey(&scalar), nil
+}
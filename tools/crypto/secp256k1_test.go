@@ -0,0 +1,105 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecp256k1_Sign(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		privateKey string
+		publicKey  string
+		signature  string
+	}{
+		{
+			name:       "test 1",
+			data:       "68656c6c6f",
+			privateKey: "spsk2oTAhiaSywh9ctt8yZLRxL3bo8Mayd3hKFi5iBaoqj2R8bx7ow",
+			publicKey:  "sppk7auhfZa5wAcR8hk3WCw47kHgG3Pp8zaP3ctdAqdDd2dBAeZBof1",
+			signature:  "spsig1PkUnmFBdRZsL1wiPUHHpztXY3dn5xGYAjYbwGsX1o75Mv2y5xrUqBfoH5YbxnqZ2ZD8sKu4EoV46UApT4USYefs4o4fWW",
+		}, {
+			name:       "test 2",
+			data:       "03f265006be7d2f678d4ca8cae9f1877b40a76a21f8b05e73254043af01f3638786c00739ab9281b15479d756572217dc8bb944f2b02a7880ef0e42ac350f403c0843d00008384a29947e81770a1586cb268552a25ee81fb7a00",
+			privateKey: "spsk2oTAhiaSywh9ctt8yZLRxL3bo8Mayd3hKFi5iBaoqj2R8bx7ow",
+			publicKey:  "sppk7auhfZa5wAcR8hk3WCw47kHgG3Pp8zaP3ctdAqdDd2dBAeZBof1",
+			signature:  "spsig1W4EqkjYH6ssBR7AmxvWhDQkGULZPetbyrVw3zftULNSnVADVzt7aw88CBHkog4fWk4GVa3qLQYTjk8UQD15cB1o4V6zph",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curve := NewSecp256k1()
+
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+
+			sk, err := encoding.DecodeBase58(tt.privateKey)
+			require.NoError(t, err)
+
+			pk, err := curve.GetPublicKey(sk)
+			require.NoError(t, err)
+
+			wantPk, err := encoding.DecodeBase58(tt.publicKey)
+			require.NoError(t, err)
+			require.Equal(t, wantPk, pk)
+
+			got, err := curve.Sign(data, sk)
+			require.NoError(t, err)
+			require.Len(t, got.Bytes(), 64)
+
+			var s secp256k1.ModNScalar
+			s.SetByteSlice(got.Bytes()[32:])
+			require.False(t, s.IsOverHalfOrder(), "signature is not normalized")
+
+			signature, err := got.Base58()
+			require.NoError(t, err)
+			require.Equal(t, tt.signature, signature)
+
+			require.True(t, curve.Verify(data, got.Bytes(), pk))
+			require.False(t, curve.Verify(append(data, 1), got.Bytes(), pk))
+		})
+	}
+}
+
+// Known answer tests of RFC 6979 nonce generation on secp256k1 with SHA-256 digests (private key is 1)
+func TestSecp256k1_SignDigest(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		signature string
+	}{
+		{
+			name:      "Satoshi Nakamoto",
+			message:   "Satoshi Nakamoto",
+			signature: "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d82442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+		}, {
+			name:      "tears in rain",
+			message:   "All those moments will be lost in time, like tears in rain. Time to die...",
+			signature: "8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+		},
+	}
+
+	curve := NewSecp256k1()
+	privateKey := make([]byte, secp256k1PrivateKeySize)
+	privateKey[31] = 1
+
+	pk, err := curve.GetPublicKey(privateKey)
+	require.NoError(t, err)
+	require.Equal(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(pk))
+
+	sk, err := curve.parsePrivateKey(privateKey)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest := sha256.Sum256([]byte(tt.message))
+			require.Equal(t, tt.signature, hex.EncodeToString(curve.signDigest(sk, digest[:])))
+		})
+	}
+}
@@ -2,8 +2,10 @@ package crypto
 
 import (
 	"encoding/hex"
+	"strings"
 
 	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
 )
 
 // Signature -
@@ -36,3 +38,29 @@ func (s Signature) Hex() string {
 func (s Signature) String() string {
 	return s.Hex()
 }
+
+// NewSignatureFromBase58 -
+func NewSignatureFromBase58(data string) (Signature, error) {
+	var prefix string
+	for _, p := range []string{
+		encoding.PrefixED25519Signature,
+		encoding.PrefixSecp256k1Signature,
+		encoding.PrefixP256Signature,
+		encoding.PrefixBLS12381Signature,
+		encoding.PrefixGenericSignature,
+	} {
+		if strings.HasPrefix(data, p) {
+			prefix = p
+			break
+		}
+	}
+	if prefix == "" {
+		return Signature{}, errors.Errorf("invalid signature string: %s", data)
+	}
+
+	bytes, err := encoding.DecodeBase58(data)
+	if err != nil {
+		return Signature{}, err
+	}
+	return NewSignature(bytes, []byte(prefix)), nil
+}
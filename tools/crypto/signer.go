@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Magic bytes (watermarks) which are prefixed to signed data
+const (
+	WatermarkBlock                     byte = 0x01
+	WatermarkEndorsement               byte = 0x02
+	WatermarkGenericOperation          byte = 0x03
+	WatermarkRemoteSignerAuthorization byte = 0x04
+	WatermarkTenderbakeBlock           byte = 0x11
+	WatermarkPreattestation            byte = 0x12
+	WatermarkAttestation               byte = 0x13
+)
+
+// Signer - signs data on behalf of the address. Data passed to `Sign` must be prefixed with magic byte (watermark).
+type Signer interface {
+	PublicKey(ctx context.Context, address string) (PubKey, error)
+	Sign(ctx context.Context, address string, data []byte) (Signature, error)
+}
+
+// PossessionProver - signer which can prove possession of BLS (tz4) keys. The proof is required to reveal tz4 account.
+type PossessionProver interface {
+	ProvePossession(ctx context.Context, address string) (Signature, error)
+}
+
+// ErrUnknownSignerKey -
+var ErrUnknownSignerKey = errors.New("signer does not know the key")
+
+// MemorySigner - signer which keeps private keys in process memory
+type MemorySigner struct {
+	keys map[string]Key
+	mx   sync.RWMutex
+}
+
+// NewMemorySigner -
+func NewMemorySigner(keys ...Key) (*MemorySigner, error) {
+	signer := &MemorySigner{
+		keys: make(map[string]Key),
+	}
+	for i := range keys {
+		if err := signer.Add(keys[i]); err != nil {
+			return nil, err
+		}
+	}
+	return signer, nil
+}
+
+// Add - adds key to signer
+func (signer *MemorySigner) Add(key Key) error {
+	address, err := key.Address()
+	if err != nil {
+		return err
+	}
+
+	signer.mx.Lock()
+	signer.keys[address] = key
+	signer.mx.Unlock()
+	return nil
+}
+
+// Addresses - returns list of addresses known by signer
+func (signer *MemorySigner) Addresses() []string {
+	signer.mx.RLock()
+	defer signer.mx.RUnlock()
+
+	addresses := make([]string, 0, len(signer.keys))
+	for address := range signer.keys {
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// PublicKey -
+func (signer *MemorySigner) PublicKey(ctx context.Context, address string) (PubKey, error) {
+	key, err := signer.key(address)
+	if err != nil {
+		return PubKey{}, err
+	}
+	return key.pubKey, nil
+}
+
+// Sign -
+func (signer *MemorySigner) Sign(ctx context.Context, address string, data []byte) (Signature, error) {
+	key, err := signer.key(address)
+	if err != nil {
+		return Signature{}, err
+	}
+	return key.Sign(data)
+}
+
+// ProvePossession -
+func (signer *MemorySigner) ProvePossession(ctx context.Context, address string) (Signature, error) {
+	key, err := signer.key(address)
+	if err != nil {
+		return Signature{}, err
+	}
+	return key.ProvePossession()
+}
+
+func (signer *MemorySigner) key(address string) (Key, error) {
+	signer.mx.RLock()
+	key, ok := signer.keys[address]
+	signer.mx.RUnlock()
+
+	if !ok {
+		return Key{}, errors.Wrap(ErrUnknownSignerKey, address)
+	}
+	return key, nil
+}
@@ -28,6 +28,7 @@ const (
 	PrefixPublicKeyTZ1                = "tz1"
 	PrefixPublicKeyTZ2                = "tz2"
 	PrefixPublicKeyTZ3                = "tz3"
+	PrefixPublicKeyTZ4                = "tz4"
 	PrefixPublicKeyKT1                = "KT1"
 	PrefixScriptExpr                  = "expr"
 	PrefixED25519Seed                 = "edsk"
@@ -49,6 +50,16 @@ const (
 	PrefixSecp256k1EncryptedSecretKey = "spesk"
 	PrefixP256EncryptedSecretKey      = "p2esk"
 	PrefixBakerHash                   = "SG1"
+	PrefixBLS12381SecretKey           = "BLsk"
+	PrefixBLS12381PublicKey           = "BLpk"
+	PrefixBLS12381Signature           = "BLsig"
+	PrefixBLS12381EncryptedSecretKey  = "BLesk"
+	PrefixSmartRollupAddress          = "sr1"
+	PrefixSmartRollupStateHash        = "srs1"
+	PrefixSmartRollupCommitmentHash   = "src1"
+	PrefixDalCommitment               = "sh"
+	PrefixBlockPayloadHash            = "vh"
+	PrefixNonceHash                   = "nce"
 )
 
 var base58Encodings = []base58Encoding{
@@ -62,6 +73,7 @@ var base58Encodings = []base58Encoding{
 	{[]byte(PrefixPublicKeyTZ1), 36, []byte{6, 161, 159}, 20, "ed25519 public key hash"},
 	{[]byte(PrefixPublicKeyTZ2), 36, []byte{6, 161, 161}, 20, "secp256k1 public key hash"},
 	{[]byte(PrefixPublicKeyTZ3), 36, []byte{6, 161, 164}, 20, "p256 public key hash"},
+	{[]byte(PrefixPublicKeyTZ4), 36, []byte{6, 161, 166}, 20, "bls12_381 public key hash"},
 	{[]byte(PrefixPublicKeyKT1), 36, []byte{2, 90, 121}, 20, "Originated address"},
 
 	{[]byte(PrefixScriptExpr), 54, []byte{13, 44, 64, 27}, 32, "script expression"},
@@ -90,6 +102,18 @@ var base58Encodings = []base58Encoding{
 	{[]byte(PrefixP256EncryptedSecretKey), 88, []byte{9, 48, 57, 115, 171}, 56, "p256_encrypted_secret_key"},
 
 	{[]byte(PrefixBakerHash), 36, []byte{3, 56, 226}, 20, "baker hash"},
+
+	{[]byte(PrefixBLS12381SecretKey), 54, []byte{3, 150, 192, 40}, 32, "bls12_381 secret key"},
+	{[]byte(PrefixBLS12381PublicKey), 76, []byte{6, 149, 135, 204}, 48, "bls12_381 public key"},
+	{[]byte(PrefixBLS12381Signature), 142, []byte{40, 171, 64, 207}, 96, "bls12_381 signature"},
+	{[]byte(PrefixBLS12381EncryptedSecretKey), 88, []byte{2, 5, 30, 53, 25}, 56, "bls12_381 encrypted secret key"},
+
+	{[]byte(PrefixSmartRollupAddress), 36, []byte{6, 124, 117}, 20, "smart rollup address"},
+	{[]byte(PrefixSmartRollupStateHash), 54, []byte{17, 165, 235, 240}, 32, "smart rollup state hash"},
+	{[]byte(PrefixSmartRollupCommitmentHash), 54, []byte{17, 165, 134, 138}, 32, "smart rollup commitment hash"},
+	{[]byte(PrefixDalCommitment), 74, []byte{2, 116, 180}, 48, "dal commitment"},
+	{[]byte(PrefixBlockPayloadHash), 52, []byte{1, 106, 242}, 32, "block payload hash"},
+	{[]byte(PrefixNonceHash), 53, []byte{69, 220, 169}, 32, "nonce hash"},
 }
 
 func getBase58EncodingForDecode(data []byte) (base58Encoding, error) {
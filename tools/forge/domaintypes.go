@@ -12,6 +12,9 @@ import (
 
 // Address -
 func Address(val string, tzOnly bool) ([]byte, error) {
+	if len(val) < 3 {
+		return nil, errors.Errorf("Invalid address: %s", val)
+	}
 	prefix := val[:3]
 	address, err := encoding.DecodeBase58(val)
 	if err != nil {
@@ -24,6 +27,8 @@ func Address(val string, tzOnly bool) ([]byte, error) {
 		address = append([]byte{0, 1}, address...)
 	case encoding.PrefixPublicKeyTZ3:
 		address = append([]byte{0, 2}, address...)
+	case encoding.PrefixPublicKeyTZ4:
+		address = append([]byte{0, 3}, address...)
 	case encoding.PrefixPublicKeyKT1:
 		address = append([]byte{1}, address...)
 		address = append(address, byte(0))
@@ -50,6 +55,8 @@ func UnforgeAddress(str string) (string, error) {
 		return encoding.EncodeBase58String(str[4:], []byte(encoding.PrefixPublicKeyTZ2))
 	case strings.HasPrefix(str, "0002"):
 		return encoding.EncodeBase58String(str[4:], []byte(encoding.PrefixPublicKeyTZ3))
+	case strings.HasPrefix(str, "0003"):
+		return encoding.EncodeBase58String(str[4:], []byte(encoding.PrefixPublicKeyTZ4))
 	case strings.HasPrefix(str, "01") && strings.HasSuffix(str, "00"):
 		return encoding.EncodeBase58String(str[2:len(str)-2], []byte(encoding.PrefixPublicKeyKT1))
 	default:
@@ -94,8 +101,11 @@ func UnforgeContract(str string) (string, error) {
 
 // PublicKey -
 func PublicKey(val string) ([]byte, error) {
-	prefix := val[4:]
-	decoded, err := encoding.DecodeBase58String(val)
+	if len(val) < 4 {
+		return nil, errors.Errorf("Invalid public key: %s", val)
+	}
+	prefix := val[:4]
+	decoded, err := encoding.DecodeBase58(val)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +116,8 @@ func PublicKey(val string) ([]byte, error) {
 		return append([]byte{1}, decoded...), nil
 	case encoding.PrefixP256PublicKey:
 		return append([]byte{2}, decoded...), nil
+	case encoding.PrefixBLS12381PublicKey:
+		return append([]byte{3}, decoded...), nil
 	default:
 		return nil, errors.Errorf("Invalid public key prefix: %s", prefix)
 	}
@@ -113,7 +125,7 @@ func PublicKey(val string) ([]byte, error) {
 
 // UnforgePublicKey -
 func UnforgePublicKey(str string) (string, error) {
-	if len(str) != 68 && len(str) != 66 {
+	if len(str) != 68 && len(str) != 66 && len(str) != 98 {
 		return "", errors.Wrapf(consts.ErrInvalidAddress, "UnforgePublicKey: %s", str)
 	}
 	switch {
@@ -123,6 +135,8 @@ func UnforgePublicKey(str string) (string, error) {
 		return encoding.EncodeBase58String(str[2:], []byte(encoding.PrefixSecp256k1PublicKey))
 	case strings.HasPrefix(str, "02"):
 		return encoding.EncodeBase58String(str[2:], []byte(encoding.PrefixP256PublicKey))
+	case strings.HasPrefix(str, "03"):
+		return encoding.EncodeBase58String(str[2:], []byte(encoding.PrefixBLS12381PublicKey))
 	default:
 		return str, nil
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 
 	"github.com/dipdup-io/go-lib/tools/types"
@@ -13,23 +14,21 @@ import (
 
 // ForgeNat -
 func ForgeNat(value *types.BigInt) ([]byte, error) {
-	if value == nil || value.Sign() == -1 {
+	if value == nil || value.Int == nil || value.Sign() == -1 {
 		return nil, errors.Errorf("invalid nat value: %v", value)
 	}
 
 	buf := new(bytes.Buffer)
-	val := value.Int64()
-
-	var end bool
-	for !end {
-		b := byte(val & 0x7f)
-		val >>= 7
-		end = val <= 0
-		if !end {
-			b |= 0x80
+	val := new(big.Int).Set(value.Int)
+
+	for {
+		b := byte(val.Uint64() & 0x7f)
+		val.Rsh(val, 7)
+		if val.Sign() == 0 {
+			buf.WriteByte(b)
+			break
 		}
-
-		buf.WriteByte(b)
+		buf.WriteByte(b | 0x80)
 	}
 
 	return buf.Bytes(), nil
@@ -172,26 +172,48 @@ const (
 )
 
 var (
-	operationTags = map[string]string{
-		"endorsement":                 "0",
-		"proposals":                   "5",
-		"ballot":                      "6",
-		"seed_nonce_revelation":       "1",
-		"double_endorsement_evidence": "2",
-		"double_baking_evidence":      "3",
-		"activate_account":            "4",
-		"reveal":                      "107",
-		"transaction":                 "108",
-		"origination":                 "109",
-		"delegation":                  "110",
-		"register_global_constant":    "111",
+	operationTags = map[string]byte{
+		"endorsement":                         0,
+		"seed_nonce_revelation":               1,
+		"double_attestation_evidence":         2,
+		"double_baking_evidence":              3,
+		"activate_account":                    4,
+		"proposals":                           5,
+		"ballot":                              6,
+		"double_preattestation_evidence":      7,
+		"preattestation":                      20,
+		"attestation":                         21,
+		"attestation_with_dal":                23,
+		"reveal":                              107,
+		"transaction":                         108,
+		"origination":                         109,
+		"delegation":                          110,
+		"register_global_constant":            111,
+		"set_deposits_limit":                  112,
+		"increase_paid_storage":               113,
+		"update_consensus_key":                114,
+		"transfer_ticket":                     158,
+		"smart_rollup_originate":              200,
+		"smart_rollup_add_messages":           201,
+		"smart_rollup_cement":                 202,
+		"smart_rollup_publish":                203,
+		"smart_rollup_refute":                 204,
+		"smart_rollup_timeout":                205,
+		"smart_rollup_execute_outbox_message": 206,
+		"smart_rollup_recover_bond":           207,
+		"dal_publish_commitment":              230,
 	}
 
 	entrypointTags = map[string]byte{
-		"default":         0,
-		"root":            1,
-		"do":              2,
-		"set_delegate":    3,
-		"remove_delegate": 4,
+		"default":                 0,
+		"root":                    1,
+		"do":                      2,
+		"set_delegate":            3,
+		"remove_delegate":         4,
+		"deposit":                 5,
+		"stake":                   6,
+		"unstake":                 7,
+		"finalize_unstake":        8,
+		"set_delegate_parameters": 9,
 	}
 )
@@ -0,0 +1,128 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/hex"
+	stdJSON "encoding/json"
+	"strings"
+
+	"github.com/dipdup-io/go-lib/tools/base"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/dipdup-io/go-lib/tools/types"
+	"github.com/pkg/errors"
+)
+
+// managerOperation - common fields of all manager operations
+type managerOperation struct {
+	Kind         string
+	Source       string
+	Fee          string
+	Counter      string
+	GasLimit     string
+	StorageLimit string
+}
+
+func (op managerOperation) forge() (*bytes.Buffer, error) {
+	buf := new(bytes.Buffer)
+
+	tag, ok := operationTags[op.Kind]
+	if !ok {
+		return nil, errors.Errorf("unknown operation tag: %s", op.Kind)
+	}
+	buf.WriteByte(tag)
+
+	source, err := Address(op.Source, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "source forging")
+	}
+	buf.Write(source)
+
+	fee, err := ForgeNat(types.NewBigIntFromString(op.Fee))
+	if err != nil {
+		return nil, errors.Wrap(err, "fee forging")
+	}
+	buf.Write(fee)
+
+	counter, err := ForgeNat(types.NewBigIntFromString(op.Counter))
+	if err != nil {
+		return nil, errors.Wrap(err, "counter forging")
+	}
+	buf.Write(counter)
+
+	gasLimit, err := ForgeNat(types.NewBigIntFromString(op.GasLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "gas limit forging")
+	}
+	buf.Write(gasLimit)
+
+	storageLimit, err := ForgeNat(types.NewBigIntFromString(op.StorageLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "storage limit forging")
+	}
+	buf.Write(storageLimit)
+
+	return buf, nil
+}
+
+// forgeMicheline - forges micheline expression in JSON with 4-byte length prefix
+func forgeMicheline(data stdJSON.RawMessage) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty micheline expression")
+	}
+
+	var node base.Node
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, errors.Wrap(err, "micheline unmarshaling")
+	}
+	forger := NewMichelson()
+	forger.Nodes = []*base.Node{&node}
+	value, err := forger.Forge()
+	if err != nil {
+		return nil, err
+	}
+	return ForgeArray(value, 4), nil
+}
+
+// forgeHexBytes - forges hex string as bytes with 4-byte length prefix
+func forgeHexBytes(value string) ([]byte, error) {
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return ForgeArray(decoded, 4), nil
+}
+
+// forgeHash - decodes base58 hash with the expected prefix
+func forgeHash(value, prefix string) ([]byte, error) {
+	if !strings.HasPrefix(value, prefix) {
+		return nil, errors.Errorf("invalid %s hash: %s", prefix, value)
+	}
+	return encoding.DecodeBase58(value)
+}
+
+// forgeOptionalAddress - forges optional implicit address
+func forgeOptionalAddress(value string) ([]byte, error) {
+	if value == "" {
+		return ForgeBool(false), nil
+	}
+	address, err := Address(value, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(ForgeBool(true), address...), nil
+}
+
+// forgeOptionalProof - forges optional BLS proof of possession of `publicKey`. The proof is accepted only for tz4 (BLpk) keys.
+func forgeOptionalProof(publicKey, value string) ([]byte, error) {
+	if value == "" {
+		return ForgeBool(false), nil
+	}
+	if !strings.HasPrefix(publicKey, encoding.PrefixBLS12381PublicKey) {
+		return nil, errors.Errorf("proof of possession is allowed only for %s keys: %s", encoding.PrefixBLS12381PublicKey, publicKey)
+	}
+	proof, err := forgeHash(value, encoding.PrefixBLS12381Signature)
+	if err != nil {
+		return nil, err
+	}
+	return append(ForgeBool(true), ForgeArray(proof, 4)...), nil
+}
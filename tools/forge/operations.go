@@ -0,0 +1,308 @@
+package forge
+
+import (
+	"encoding/hex"
+	stdJSON "encoding/json"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/dipdup-io/go-lib/tools/types"
+	"github.com/pkg/errors"
+)
+
+const dalCommitmentProofSize = 48
+
+// Reveal - forges reveal. Proof of possession is written as an option and is accepted only for tz4 public keys.
+func Reveal(reveal node.Reveal) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindReveal,
+		Source:       reveal.Source,
+		Fee:          reveal.Fee,
+		Counter:      reveal.Counter,
+		GasLimit:     reveal.GasLimit,
+		StorageLimit: reveal.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := PublicKey(reveal.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "public key forging")
+	}
+	buf.Write(pk)
+
+	proof, err := forgeOptionalProof(reveal.PublicKey, reveal.Proof)
+	if err != nil {
+		return nil, errors.Wrap(err, "proof forging")
+	}
+	buf.Write(proof)
+
+	return buf.Bytes(), nil
+}
+
+// Delegation -
+func Delegation(delegation node.Delegation) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindDelegation,
+		Source:       delegation.Source,
+		Fee:          delegation.Fee,
+		Counter:      delegation.Counter,
+		GasLimit:     delegation.GasLimit,
+		StorageLimit: delegation.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	delegate, err := forgeOptionalAddress(delegation.Delegate)
+	if err != nil {
+		return nil, errors.Wrap(err, "delegate forging")
+	}
+	buf.Write(delegate)
+
+	return buf.Bytes(), nil
+}
+
+// Origination -
+func Origination(origination node.Origination) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindOrigination,
+		Source:       origination.Source,
+		Fee:          origination.Fee,
+		Counter:      origination.Counter,
+		GasLimit:     origination.GasLimit,
+		StorageLimit: origination.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := ForgeNat(types.NewBigIntFromString(origination.Balance))
+	if err != nil {
+		return nil, errors.Wrap(err, "balance forging")
+	}
+	buf.Write(balance)
+
+	delegate, err := forgeOptionalAddress(origination.Delegate)
+	if err != nil {
+		return nil, errors.Wrap(err, "delegate forging")
+	}
+	buf.Write(delegate)
+
+	var script struct {
+		Code    stdJSON.RawMessage `json:"code"`
+		Storage stdJSON.RawMessage `json:"storage"`
+	}
+	if err := json.Unmarshal(origination.Script, &script); err != nil {
+		return nil, errors.Wrap(err, "script unmarshaling")
+	}
+
+	code, err := forgeMicheline(script.Code)
+	if err != nil {
+		return nil, errors.Wrap(err, "code forging")
+	}
+	buf.Write(code)
+
+	storage, err := forgeMicheline(script.Storage)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage forging")
+	}
+	buf.Write(storage)
+
+	return buf.Bytes(), nil
+}
+
+// RegisterGlobalConstant -
+func RegisterGlobalConstant(constant node.RegisterGlobalConstant) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindRegisterGlobalConstant,
+		Source:       constant.Source,
+		Fee:          constant.Fee,
+		Counter:      constant.Counter,
+		GasLimit:     constant.GasLimit,
+		StorageLimit: constant.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := forgeMicheline(constant.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "value forging")
+	}
+	buf.Write(value)
+
+	return buf.Bytes(), nil
+}
+
+// SetDepositsLimit -
+func SetDepositsLimit(limit node.SetDepositsLimit) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSetDepositsLimit,
+		Source:       limit.Source,
+		Fee:          limit.Fee,
+		Counter:      limit.Counter,
+		GasLimit:     limit.GasLimit,
+		StorageLimit: limit.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	hasLimit := limit.Limit != nil
+	buf.Write(ForgeBool(hasLimit))
+	if hasLimit {
+		value, err := ForgeNat(types.NewBigIntFromString(*limit.Limit))
+		if err != nil {
+			return nil, errors.Wrap(err, "limit forging")
+		}
+		buf.Write(value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// IncreasePaidStorage -
+func IncreasePaidStorage(increase node.IncreasePaidStorage) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindIncreasePaidStorage,
+		Source:       increase.Source,
+		Fee:          increase.Fee,
+		Counter:      increase.Counter,
+		GasLimit:     increase.GasLimit,
+		StorageLimit: increase.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := ForgeInt(types.NewBigIntFromString(increase.Amount))
+	if err != nil {
+		return nil, errors.Wrap(err, "amount forging")
+	}
+	buf.Write(amount)
+
+	destination, err := Address(increase.Destination, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "destination forging")
+	}
+	buf.Write(destination)
+
+	return buf.Bytes(), nil
+}
+
+// TransferTicket -
+func TransferTicket(transfer node.TransferTicket) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindTransferTicket,
+		Source:       transfer.Source,
+		Fee:          transfer.Fee,
+		Counter:      transfer.Counter,
+		GasLimit:     transfer.GasLimit,
+		StorageLimit: transfer.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := forgeMicheline(transfer.TicketContents)
+	if err != nil {
+		return nil, errors.Wrap(err, "ticket contents forging")
+	}
+	buf.Write(contents)
+
+	typ, err := forgeMicheline(transfer.TicketType)
+	if err != nil {
+		return nil, errors.Wrap(err, "ticket type forging")
+	}
+	buf.Write(typ)
+
+	ticketer, err := Address(transfer.TicketTicketer, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "ticketer forging")
+	}
+	buf.Write(ticketer)
+
+	amount, err := ForgeNat(types.NewBigIntFromString(transfer.TicketAmount))
+	if err != nil {
+		return nil, errors.Wrap(err, "ticket amount forging")
+	}
+	buf.Write(amount)
+
+	destination, err := Address(transfer.Destination, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "destination forging")
+	}
+	buf.Write(destination)
+
+	buf.Write(ForgeArray([]byte(transfer.Entrypoint), 4))
+
+	return buf.Bytes(), nil
+}
+
+// UpdateConsensusKey - forges update_consensus_key. Proof of possession is written as an option and is accepted only for tz4 keys.
+func UpdateConsensusKey(update node.UpdateConsensusKey) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindUpdateConsensusKey,
+		Source:       update.Source,
+		Fee:          update.Fee,
+		Counter:      update.Counter,
+		GasLimit:     update.GasLimit,
+		StorageLimit: update.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := PublicKey(update.Pk)
+	if err != nil {
+		return nil, errors.Wrap(err, "public key forging")
+	}
+	buf.Write(pk)
+
+	proof, err := forgeOptionalProof(update.Pk, update.Proof)
+	if err != nil {
+		return nil, errors.Wrap(err, "proof forging")
+	}
+	buf.Write(proof)
+
+	return buf.Bytes(), nil
+}
+
+// DalPublishCommitment -
+func DalPublishCommitment(publish node.DalPublishCommitment) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindDalPublishCommitment,
+		Source:       publish.Source,
+		Fee:          publish.Fee,
+		Counter:      publish.Counter,
+		GasLimit:     publish.GasLimit,
+		StorageLimit: publish.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	if publish.SlotHeader.SlotIndex < 0 || publish.SlotHeader.SlotIndex > 255 {
+		return nil, errors.Errorf("invalid slot index: %d", publish.SlotHeader.SlotIndex)
+	}
+	buf.WriteByte(byte(publish.SlotHeader.SlotIndex))
+
+	commitment, err := forgeHash(publish.SlotHeader.Commitment, encoding.PrefixDalCommitment)
+	if err != nil {
+		return nil, errors.Wrap(err, "commitment forging")
+	}
+	buf.Write(commitment)
+
+	proof, err := hex.DecodeString(publish.SlotHeader.CommitmentProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "commitment proof forging")
+	}
+	if len(proof) != dalCommitmentProofSize {
+		return nil, errors.Errorf("invalid commitment proof length: %d", len(proof))
+	}
+	buf.Write(proof)
+
+	return buf.Bytes(), nil
+}
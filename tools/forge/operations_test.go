@@ -0,0 +1,143 @@
+package forge
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		name    string
+		opJSON  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "reveal",
+			opJSON: `{"kind":"reveal","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","public_key":"edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ"}`,
+			want:   "6b00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f3400",
+		}, {
+			name:   "reveal secp256k1",
+			opJSON: `{"kind":"reveal","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","public_key":"sppk7c3Fz7QqhZqY2FZUWWAnDuqTwx4KwDjgFA4VeLPiV8n4tnbsVzG"}`,
+			want:   "6b00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020103682c3aaa998fd9adfe8111cd42cc0daedb5d97647e6020eb629fbc91b613f72100",
+		}, {
+			name:   "delegation",
+			opJSON: `{"kind":"delegation","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","delegate":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq"}`,
+			want:   "6e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102ff0052b07c560835c0830a2fd343169b901921a492fe",
+		}, {
+			name:   "undelegation",
+			opJSON: `{"kind":"delegation","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257"}`,
+			want:   "6e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200",
+		}, {
+			name:   "origination",
+			opJSON: `{"kind":"origination","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","balance":"1000000","script":{"code":[{"prim":"parameter","args":[{"prim":"unit"}]},{"prim":"storage","args":[{"prim":"nat"}]},{"prim":"code","args":[[{"prim":"CDR"},{"prim":"NIL","args":[{"prim":"operation"}]},{"prim":"PAIR"}]]}],"storage":{"int":"42"}}}`,
+			want:   "6d00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102c0843d000000001c02000000170500036c05010362050202000000080317053d036d034200000002002a",
+		}, {
+			name:   "origination with delegate",
+			opJSON: `{"kind":"origination","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","balance":"0","delegate":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq","script":{"code":[{"prim":"parameter","args":[{"prim":"unit"}]},{"prim":"storage","args":[{"prim":"unit"}]},{"prim":"code","args":[[{"prim":"CDR"},{"prim":"NIL","args":[{"prim":"operation"}]},{"prim":"PAIR"}]]}],"storage":{"prim":"Unit"}}}`,
+			want:   "6d00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200ff0052b07c560835c0830a2fd343169b901921a492fe0000001c02000000170500036c0501036c050202000000080317053d036d034200000002030b",
+		}, {
+			name:   "register_global_constant",
+			opJSON: `{"kind":"register_global_constant","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","value":{"prim":"Pair","args":[{"int":"1"},{"string":"abc"}]}}`,
+			want:   "6f00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000c070700010100000003616263",
+		}, {
+			name:   "set_deposits_limit",
+			opJSON: `{"kind":"set_deposits_limit","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","limit":"1000000"}`,
+			want:   "7000e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102ffc0843d",
+		}, {
+			name:   "set_deposits_limit without limit",
+			opJSON: `{"kind":"set_deposits_limit","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257"}`,
+			want:   "7000e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200",
+		}, {
+			name:   "increase_paid_storage",
+			opJSON: `{"kind":"increase_paid_storage","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","amount":"100","destination":"KT1SkmB19o8nfhRvG9LL7TjDfX2Bm1nCuYoY"}`,
+			want:   "7100e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102a40101c756189bc655cc487d57e5fefe482449dbe00c3900",
+		}, {
+			name:   "transfer_ticket",
+			opJSON: `{"kind":"transfer_ticket","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","ticket_contents":{"string":"hello"},"ticket_ty":{"prim":"string"},"ticket_ticketer":"KT1SkmB19o8nfhRvG9LL7TjDfX2Bm1nCuYoY","ticket_amount":"300","destination":"KT1XdCkJncWfGvqf1NdbK2HBRTvRcHhJtNx5","entrypoint":"receive"}`,
+			want:   "9e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000a010000000568656c6c6f00000002036801c756189bc655cc487d57e5fefe482449dbe00c3900ac0201fcc0bee1480bfca3a80481904cee4099400b1c8d000000000772656365697665",
+		}, {
+			name:   "update_consensus_key",
+			opJSON: `{"kind":"update_consensus_key","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","pk":"edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ"}`,
+			want:   "7200e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f3400",
+		}, {
+			name:    "reveal with proof for non-tz4 key",
+			opJSON:  `{"kind":"reveal","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","public_key":"edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ","proof":"BLsig9dd4pT3jVSqdEG51W1wfeZHmyem6CweaXGz96gVu3BxpJKru58wVNGi5BA4CQ4SnPdB9JxajeemvjQxRBxkC4o3E1APeKNkb3dJW6Sgb8BEzv8VBQEaMMeiSSKBo1XvmViiN4KjvF"}`,
+			wantErr: true,
+		}, {
+			name:    "update_consensus_key with proof for non-tz4 key",
+			opJSON:  `{"kind":"update_consensus_key","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","pk":"edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ","proof":"BLsig9dd4pT3jVSqdEG51W1wfeZHmyem6CweaXGz96gVu3BxpJKru58wVNGi5BA4CQ4SnPdB9JxajeemvjQxRBxkC4o3E1APeKNkb3dJW6Sgb8BEzv8VBQEaMMeiSSKBo1XvmViiN4KjvF"}`,
+			wantErr: true,
+		}, {
+			name:   "smart_rollup_add_messages",
+			opJSON: `{"kind":"smart_rollup_add_messages","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","message":["0102","ff"]}`,
+			want:   "c900e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000b00000002010200000001ff",
+		}, {
+			name:   "smart_rollup_cement",
+			opJSON: `{"kind":"smart_rollup_cement","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf"}`,
+			want:   "ca00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf",
+		}, {
+			name:   "smart_rollup_recover_bond",
+			opJSON: `{"kind":"smart_rollup_recover_bond","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","staker":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq"}`,
+			want:   "cf00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0052b07c560835c0830a2fd343169b901921a492fe",
+		}, {
+			name:   "smart_rollup_timeout",
+			opJSON: `{"kind":"smart_rollup_timeout","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","stakers":{"alice":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq","bob":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm"}}`,
+			want:   "cd00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0052b07c560835c0830a2fd343169b901921a492fe00e8b36c80efb51ec85a14562426049aa182a3ce38",
+		}, {
+			name:   "smart_rollup_originate",
+			opJSON: `{"kind":"smart_rollup_originate","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","pvm_kind":"wasm_2_0_0","kernel":"0a0b","parameters_ty":{"prim":"bytes"}}`,
+			want:   "c800e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810201000000020a0b00000002036900",
+		}, {
+			// expected bytes are assembled by hand from octez binary encoding of the operations
+			name:   "reveal tz4 with proof",
+			opJSON: `{"kind":"reveal","source":"tz4A8vsT22iNv8ViCWaZwM8qVTGafb1SRCY3","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","public_key":"BLpk1rCWChxm7KJiqxoTxT1gXqbLqLYukCQ7dTcPY6GPUTrYAP1ZsWFYeAARhjF7e9o8WdvVnz7M","proof":"BLsigB5ZQQeShKTMrzWp2tDE1G1QmbzkGz2qArSnTXLccdyLT5mV6y1RHJm8pbjkBr5qG2bSPaLkySJPXNn2wpWd9YCdPuKNPi6x2yuoYNj1Y4GgMn39aPxtm4RuCqxHFczTeNFaGq5v7X"}`,
+			want:   "6b030c6bd4e11bb143d3af9c7ceef0829b293d1314f6d209828018f10b81020397248533cef0908a5ebe52c3b487471301bf6369010e6167f63dd74feddac2dfb5336a59a331d38eb0e454d6f6fcb1a4ff00000060a87b11ba82bdb45cbfbc7a41afcfe8053d9083e2e8ee43abab76019bc530f8da508cd7c802886fc45c14dd6d9b0e30b506c8a1206ec456ea4132e9f8ba4a4f194fefe6b9ea3399657038a00042792f1ca793c18bfd1d068a8d91356a346cc775",
+		}, {
+			name:   "update_consensus_key tz4 with proof",
+			opJSON: `{"kind":"update_consensus_key","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","pk":"BLpk1rCWChxm7KJiqxoTxT1gXqbLqLYukCQ7dTcPY6GPUTrYAP1ZsWFYeAARhjF7e9o8WdvVnz7M","proof":"BLsigB5ZQQeShKTMrzWp2tDE1G1QmbzkGz2qArSnTXLccdyLT5mV6y1RHJm8pbjkBr5qG2bSPaLkySJPXNn2wpWd9YCdPuKNPi6x2yuoYNj1Y4GgMn39aPxtm4RuCqxHFczTeNFaGq5v7X"}`,
+			want:   "7200e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020397248533cef0908a5ebe52c3b487471301bf6369010e6167f63dd74feddac2dfb5336a59a331d38eb0e454d6f6fcb1a4ff00000060a87b11ba82bdb45cbfbc7a41afcfe8053d9083e2e8ee43abab76019bc530f8da508cd7c802886fc45c14dd6d9b0e30b506c8a1206ec456ea4132e9f8ba4a4f194fefe6b9ea3399657038a00042792f1ca793c18bfd1d068a8d91356a346cc775",
+		}, {
+			name:   "smart_rollup_publish",
+			opJSON: `{"kind":"smart_rollup_publish","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","commitment":{"compressed_state":"srs11SS2PujfsMkBCPur7Pt28dGUWBjRxr3gmgjgRtSoj2e58XxyYf","inbox_level":4321,"predecessor":"src12iuLJBeaviZmxrg9uxcnc6io8UebvNbYvBhrhjQ6rurE4JLsgg","number_of_ticks":"880000000000"}}`,
+			want:   "cb00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20000010e12122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f40000000cce4166000",
+		}, {
+			name:   "smart_rollup_execute_outbox_message",
+			opJSON: `{"kind":"smart_rollup_execute_outbox_message","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","cemented_commitment":"src12iuLJBeaviZmxrg9uxcnc6io8UebvNbYvBhrhjQ6rurE4JLsgg","output_proof":"0a0b0c"}`,
+			want:   "ce00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf2122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f40000000030a0b0c",
+		}, {
+			name:   "smart_rollup_refute start",
+			opJSON: `{"kind":"smart_rollup_refute","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","opponent":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq","refutation":{"refutation_kind":"start","player_commitment_hash":"src13mUSL6nujkxBztsGSMcRMPpXzpT4hpYTNby8Rt8NZYzcRnMoER","opponent_commitment_hash":"src13tzP9PzdhY6oKXuzcL9MJmUL58JEx9GWR5JsuXb3CtMSjZvpKn"}}`,
+			want:   "cc00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0052b07c560835c0830a2fd343169b901921a492fe00aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaabbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}, {
+			name:   "dal_publish_commitment",
+			opJSON: `{"kind":"dal_publish_commitment","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","slot_header":{"slot_index":7,"commitment":"sh1dEJtPxqkB8PPoVyLcxw7RAHdKBpxYgme9xoVTkNu5v6VqSyA9vn3YeaSxgctUpj6uZsMFw6","commitment_proof":"808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeaf"}}`,
+			want:   "e600e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810207404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeaf",
+		}, {
+			name:    "refutation move",
+			opJSON:  `{"kind":"smart_rollup_refute","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","fee":"1234","counter":"393218","gas_limit":"1521","storage_limit":"257","rollup":"sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf","opponent":"tz1TBFXHmJGZh7XpdMDCrPkxFKYo3ffNrYaq","refutation":{"refutation_kind":"move"}}`,
+			wantErr: true,
+		}, {
+			name:    "unsupported kind",
+			opJSON:  `{"kind":"ballot","source":"tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm","period":1,"proposal":"PtNairobiyssHuh87hEhfVBGCVrK3WnS8Z2FT4ymB5tAa4r1nQf","ballot":"yay"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operation node.Operation
+			require.NoError(t, json.UnmarshalFromString(tt.opJSON, &operation))
+
+			got, err := Operation(operation)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, hex.EncodeToString(got))
+		})
+	}
+}
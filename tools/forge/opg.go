@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-// OPG -
+// OPG - forges operation group. It returns error if any operation kind is not supported.
 func OPG(branch string, operations ...node.Operation) ([]byte, error) {
 	result := new(bytes.Buffer)
 	if branch != "" {
@@ -20,19 +20,56 @@ func OPG(branch string, operations ...node.Operation) ([]byte, error) {
 	}
 
 	for i := range operations {
-		switch typ := operations[i].Body.(type) {
-
-		// TODO: realize others operation kinds
-		case node.Transaction:
-			forged, err := Transaction(typ)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to forge transaction")
-			}
-			result.Write(forged)
-		default:
+		forged, err := Operation(operations[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to forge operation %d", i)
 		}
-
+		result.Write(forged)
 	}
 
 	return result.Bytes(), nil
 }
+
+// Operation - forges single manager operation
+func Operation(operation node.Operation) ([]byte, error) {
+	switch typ := operation.Body.(type) {
+	case node.Transaction:
+		return Transaction(typ)
+	case node.Reveal:
+		return Reveal(typ)
+	case node.Delegation:
+		return Delegation(typ)
+	case node.Origination:
+		return Origination(typ)
+	case node.RegisterGlobalConstant:
+		return RegisterGlobalConstant(typ)
+	case node.SetDepositsLimit:
+		return SetDepositsLimit(typ)
+	case node.IncreasePaidStorage:
+		return IncreasePaidStorage(typ)
+	case node.TransferTicket:
+		return TransferTicket(typ)
+	case node.UpdateConsensusKey:
+		return UpdateConsensusKey(typ)
+	case node.SmartRollupOriginate:
+		return SmartRollupOriginate(typ)
+	case node.SmartRollupAddMessage:
+		return SmartRollupAddMessages(typ)
+	case node.SmartRollupCement:
+		return SmartRollupCement(typ)
+	case node.SmartRollupPublish:
+		return SmartRollupPublish(typ)
+	case node.SmartRollupRefute:
+		return SmartRollupRefute(typ)
+	case node.SmartRollupTimeout:
+		return SmartRollupTimeout(typ)
+	case node.SmartRollupExecute:
+		return SmartRollupExecuteOutboxMessage(typ)
+	case node.SmartRollupRecoverBond:
+		return SmartRollupRecoverBond(typ)
+	case node.DalPublishCommitment:
+		return DalPublishCommitment(typ)
+	default:
+		return nil, errors.Errorf("unsupported operation kind: %s (%T)", operation.Kind, operation.Body)
+	}
+}
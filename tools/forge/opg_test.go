@@ -59,6 +59,47 @@ func TestOPG(t *testing.T) {
 			},
 			want: "5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af7506c00e8b36c80efb51ec85a14562426049aa182a3ce38df02828018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce38006c00e8b36c80efb51ec85a14562426049aa182a3ce38df02838018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce38006c00e8b36c80efb51ec85a14562426049aa182a3ce38df02848018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce3800",
 		},
+		{
+			name:   "reveal and transaction",
+			branch: "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+			operations: []node.Operation{
+				{
+					Kind: node.KindReveal,
+					Body: node.Reveal{
+						Counter:      "393217",
+						Fee:          "1234",
+						GasLimit:     "1521",
+						PublicKey:    "edpkvZNKsgFb7D7HLxnJ68cUgqEsZ47Qw81WGMndQLDvvziqcn9nVQ",
+						Source:       "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+						StorageLimit: "257",
+					},
+				},
+				{
+					Kind: node.KindTransaction,
+					Body: node.Transaction{
+						Amount:       "1000",
+						Counter:      "393218",
+						Destination:  "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+						Fee:          "351",
+						GasLimit:     "1521",
+						Source:       "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm",
+						StorageLimit: "100",
+					},
+				},
+			},
+			want: "5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af7506b00e8b36c80efb51ec85a14562426049aa182a3ce38d209818018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f34006c00e8b36c80efb51ec85a14562426049aa182a3ce38df02828018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce3800",
+		},
+		{
+			name:   "unsupported operation",
+			branch: "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+			operations: []node.Operation{
+				{
+					Kind: node.KindBallot,
+					Body: node.Ballot{},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
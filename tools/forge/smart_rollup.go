@@ -0,0 +1,313 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/dipdup-io/go-lib/tools/types"
+	"github.com/pkg/errors"
+)
+
+// PVM kinds
+const (
+	PvmKindArith = "arith"
+	PvmKindWasm  = "wasm_2_0_0"
+	PvmKindRiscv = "riscv"
+)
+
+var pvmKindTags = map[string]byte{
+	PvmKindArith: 0,
+	PvmKindWasm:  1,
+	PvmKindRiscv: 2,
+}
+
+// refutation kinds
+const (
+	RefutationKindStart = "start"
+	RefutationKindMove  = "move"
+)
+
+// SmartRollupOriginate -
+func SmartRollupOriginate(originate node.SmartRollupOriginate) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrOriginate,
+		Source:       originate.Source,
+		Fee:          originate.Fee,
+		Counter:      originate.Counter,
+		GasLimit:     originate.GasLimit,
+		StorageLimit: originate.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	pvmKind, ok := pvmKindTags[originate.PvmKind]
+	if !ok {
+		return nil, errors.Errorf("unknown pvm kind: %s", originate.PvmKind)
+	}
+	buf.WriteByte(pvmKind)
+
+	kernel, err := forgeHexBytes(originate.Kernel)
+	if err != nil {
+		return nil, errors.Wrap(err, "kernel forging")
+	}
+	buf.Write(kernel)
+
+	parametersType, err := forgeMicheline(originate.ParametersTy)
+	if err != nil {
+		return nil, errors.Wrap(err, "parameters type forging")
+	}
+	buf.Write(parametersType)
+
+	hasWhitelist := len(originate.Whitelist) > 0
+	buf.Write(ForgeBool(hasWhitelist))
+	if hasWhitelist {
+		whitelist := new(bytes.Buffer)
+		for i := range originate.Whitelist {
+			address, err := Address(originate.Whitelist[i], true)
+			if err != nil {
+				return nil, errors.Wrap(err, "whitelist forging")
+			}
+			whitelist.Write(address)
+		}
+		buf.Write(ForgeArray(whitelist.Bytes(), 4))
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupAddMessages -
+func SmartRollupAddMessages(add node.SmartRollupAddMessage) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrAddMessages,
+		Source:       add.Source,
+		Fee:          add.Fee,
+		Counter:      add.Counter,
+		GasLimit:     add.GasLimit,
+		StorageLimit: add.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	messages := new(bytes.Buffer)
+	for i := range add.Message {
+		message, err := forgeHexBytes(add.Message[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "message %d forging", i)
+		}
+		messages.Write(message)
+	}
+	buf.Write(ForgeArray(messages.Bytes(), 4))
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupCement -
+func SmartRollupCement(cement node.SmartRollupCement) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrCement,
+		Source:       cement.Source,
+		Fee:          cement.Fee,
+		Counter:      cement.Counter,
+		GasLimit:     cement.GasLimit,
+		StorageLimit: cement.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(cement.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupPublish -
+func SmartRollupPublish(publish node.SmartRollupPublish) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrPublish,
+		Source:       publish.Source,
+		Fee:          publish.Fee,
+		Counter:      publish.Counter,
+		GasLimit:     publish.GasLimit,
+		StorageLimit: publish.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(publish.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	state, err := forgeHash(publish.Commitment.CompressedState, encoding.PrefixSmartRollupStateHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "compressed state forging")
+	}
+	buf.Write(state)
+
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(publish.Commitment.InboxLevel)))
+
+	predecessor, err := forgeHash(publish.Commitment.Predecessor, encoding.PrefixSmartRollupCommitmentHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "predecessor forging")
+	}
+	buf.Write(predecessor)
+
+	ticks := types.NewBigIntFromString(publish.Commitment.NumberOfTicks)
+	if ticks.Int == nil || !ticks.IsInt64() || ticks.Sign() < 0 {
+		return nil, errors.Errorf("invalid number of ticks: %s", publish.Commitment.NumberOfTicks)
+	}
+	buf.Write(binary.BigEndian.AppendUint64(nil, uint64(ticks.Int64())))
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupRefute - forges refutation game. Only start of the game is supported because node model doesn't contain move proofs.
+func SmartRollupRefute(refute node.SmartRollupRefute) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrRefute,
+		Source:       refute.Source,
+		Fee:          refute.Fee,
+		Counter:      refute.Counter,
+		GasLimit:     refute.GasLimit,
+		StorageLimit: refute.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(refute.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	opponent, err := Address(refute.Opponent, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "opponent forging")
+	}
+	buf.Write(opponent)
+
+	if refute.Refutation.RefutationKind != RefutationKindStart {
+		return nil, errors.Errorf("unsupported refutation kind: %s", refute.Refutation.RefutationKind)
+	}
+	buf.WriteByte(0)
+
+	player, err := forgeHash(refute.Refutation.PlayerCommitmentHash, encoding.PrefixSmartRollupCommitmentHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "player commitment hash forging")
+	}
+	buf.Write(player)
+
+	opponentHash, err := forgeHash(refute.Refutation.OpponentCommitmentHash, encoding.PrefixSmartRollupCommitmentHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "opponent commitment hash forging")
+	}
+	buf.Write(opponentHash)
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupTimeout -
+func SmartRollupTimeout(timeout node.SmartRollupTimeout) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrTimeout,
+		Source:       timeout.Source,
+		Fee:          timeout.Fee,
+		Counter:      timeout.Counter,
+		GasLimit:     timeout.GasLimit,
+		StorageLimit: timeout.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(timeout.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	for _, staker := range []string{timeout.Stakers.Alice, timeout.Stakers.Bob} {
+		address, err := Address(staker, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "stakers forging")
+		}
+		buf.Write(address)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupExecuteOutboxMessage -
+func SmartRollupExecuteOutboxMessage(execute node.SmartRollupExecute) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrExecute,
+		Source:       execute.Source,
+		Fee:          execute.Fee,
+		Counter:      execute.Counter,
+		GasLimit:     execute.GasLimit,
+		StorageLimit: execute.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(execute.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	commitment, err := forgeHash(execute.CementedCommitment, encoding.PrefixSmartRollupCommitmentHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "cemented commitment forging")
+	}
+	buf.Write(commitment)
+
+	proof, err := forgeHexBytes(execute.OutputProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "output proof forging")
+	}
+	buf.Write(proof)
+
+	return buf.Bytes(), nil
+}
+
+// SmartRollupRecoverBond -
+func SmartRollupRecoverBond(bond node.SmartRollupRecoverBond) ([]byte, error) {
+	buf, err := managerOperation{
+		Kind:         node.KindSrRecoverBond,
+		Source:       bond.Source,
+		Fee:          bond.Fee,
+		Counter:      bond.Counter,
+		GasLimit:     bond.GasLimit,
+		StorageLimit: bond.StorageLimit,
+	}.forge()
+	if err != nil {
+		return nil, err
+	}
+
+	rollup, err := forgeHash(bond.Rollup, encoding.PrefixSmartRollupAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollup forging")
+	}
+	buf.Write(rollup)
+
+	staker, err := Address(bond.Staker, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "staker forging")
+	}
+	buf.Write(staker)
+
+	return buf.Bytes(), nil
+}
@@ -12,48 +12,17 @@ import (
 
 // Transaction -
 func Transaction(transaction node.Transaction) ([]byte, error) {
-	buf := new(bytes.Buffer)
-
-	tag, ok := operationTags[node.KindTransaction]
-	if !ok {
-		return nil, errors.Errorf("unknown operation tag: %s", node.KindTransaction)
-	}
-
-	kind, err := ForgeNat(types.NewBigIntFromString(tag))
-	if err != nil {
-		return nil, errors.Wrap(err, "kind forging")
-	}
-	buf.Write(kind)
-
-	source, err := Address(transaction.Source, true)
-	if err != nil {
-		return nil, errors.Wrap(err, "source forging")
-	}
-	buf.Write(source)
-
-	fee, err := ForgeNat(types.NewBigIntFromString(transaction.Fee))
-	if err != nil {
-		return nil, errors.Wrap(err, "fee forging")
-	}
-	buf.Write(fee)
-
-	counter, err := ForgeNat(types.NewBigIntFromString(transaction.Counter))
-	if err != nil {
-		return nil, errors.Wrap(err, "counter forging")
-	}
-	buf.Write(counter)
-
-	gasLimit, err := ForgeNat(types.NewBigIntFromString(transaction.GasLimit))
-	if err != nil {
-		return nil, errors.Wrap(err, "gas limit forging")
-	}
-	buf.Write(gasLimit)
-
-	storageLimit, err := ForgeNat(types.NewBigIntFromString(transaction.StorageLimit))
+	buf, err := managerOperation{
+		Kind:         node.KindTransaction,
+		Source:       transaction.Source,
+		Fee:          transaction.Fee,
+		Counter:      transaction.Counter,
+		GasLimit:     transaction.GasLimit,
+		StorageLimit: transaction.StorageLimit,
+	}.forge()
 	if err != nil {
-		return nil, errors.Wrap(err, "storage limit forging")
+		return nil, err
 	}
-	buf.Write(storageLimit)
 
 	amount, err := ForgeNat(types.NewBigIntFromString(transaction.Amount))
 	if err != nil {
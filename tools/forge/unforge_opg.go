@@ -0,0 +1,1038 @@
+package forge
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	stdJSON "encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/dipdup-io/go-lib/tools/encoding"
+	"github.com/pkg/errors"
+)
+
+const (
+	branchSize           = 32
+	genericSignatureSize = 64
+	blsSignatureSize     = 96
+)
+
+// UnforgeOPG - decodes forged operation group to branch, contents and signature. `signatureSize` is the size of the trailing signature passed by the caller:
+// 0 for unsigned group, 64 for tz1/tz2/tz3 signers and 96 for tz4 signers.
+// Manager operations, (pre)attestations, legacy endorsements, double signing evidences, seed nonce revelations, ballots, proposals and account activations are supported.
+func UnforgeOPG(data []byte, signatureSize int) (node.OperationGroup, error) {
+	var group node.OperationGroup
+	switch signatureSize {
+	case 0, genericSignatureSize, blsSignatureSize:
+	default:
+		return group, errors.Errorf("invalid signature size: %d", signatureSize)
+	}
+	if len(data) < branchSize+signatureSize {
+		return group, errors.Wrap(ErrTooFewBytes, fmt.Sprintf("UnforgeOPG: %d < %d", len(data), branchSize+signatureSize))
+	}
+
+	branch, err := encoding.EncodeBase58(data[:branchSize], []byte(encoding.PrefixBlockHash))
+	if err != nil {
+		return group, errors.Wrap(err, "branch unforging")
+	}
+	group.Branch = branch
+
+	contents, err := unforgeContents(data[branchSize : len(data)-signatureSize])
+	if err != nil {
+		return group, err
+	}
+	group.Contents = contents
+
+	if signatureSize > 0 {
+		group.Signature, err = unforgeSignature(data[len(data)-signatureSize:])
+		if err != nil {
+			return group, errors.Wrap(err, "signature unforging")
+		}
+	}
+
+	return group, nil
+}
+
+// UnforgeOperation - decodes single operation. It returns decoded operation and count of read bytes.
+func UnforgeOperation(data []byte) (node.Operation, int, error) {
+	d := &decoder{data: data}
+	operation, err := d.operation()
+	return operation, d.offset, err
+}
+
+func unforgeContents(data []byte) ([]node.Operation, error) {
+	contents := make([]node.Operation, 0)
+	for len(data) > 0 {
+		operation, n, err := UnforgeOperation(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unforge operation %d", len(contents))
+		}
+		contents = append(contents, operation)
+		data = data[n:]
+	}
+	return contents, nil
+}
+
+// decoder - sequential reader of forged operation
+type decoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *decoder) bytes(size int) ([]byte, error) {
+	if size < 0 || len(d.data)-d.offset < size {
+		return nil, errors.Wrap(ErrTooFewBytes, fmt.Sprintf("decoder: %d < %d", len(d.data)-d.offset, size))
+	}
+	value := d.data[d.offset : d.offset+size]
+	d.offset += size
+	return value, nil
+}
+
+func (d *decoder) byte() (byte, error) {
+	value, err := d.bytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return value[0], nil
+}
+
+func (d *decoder) bool() (bool, error) {
+	value, err := d.byte()
+	if err != nil {
+		return false, err
+	}
+	switch value {
+	case 0x00:
+		return false, nil
+	case 0xff:
+		return true, nil
+	default:
+		return false, errors.Errorf("invalid boolean value: %x", value)
+	}
+}
+
+func (d *decoder) uint32() (uint32, error) {
+	value, err := d.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+func (d *decoder) uint64() (uint64, error) {
+	value, err := d.bytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+// dynamic - reads bytes with 4-byte length prefix
+func (d *decoder) dynamic() ([]byte, error) {
+	size, err := d.uint32()
+	if err != nil {
+		return nil, err
+	}
+	return d.bytes(int(size))
+}
+
+func (d *decoder) nat() (string, error) {
+	value := new(big.Int)
+	for shift := uint(0); ; shift += 7 {
+		b, err := d.byte()
+		if err != nil {
+			return "", err
+		}
+		part := new(big.Int).SetUint64(uint64(b & 0x7f))
+		value.Or(value, part.Lsh(part, shift))
+		if b < 0x80 {
+			break
+		}
+	}
+	return value.String(), nil
+}
+
+func (d *decoder) int() (string, error) {
+	end := d.offset
+	for end < len(d.data) && d.data[end] >= 0x80 {
+		end++
+	}
+	if end == len(d.data) {
+		return "", errors.Wrap(ErrTooFewBytes, "decoder: unterminated int")
+	}
+	value := NewInt()
+	n, err := value.Unforge(d.data[d.offset : end+1])
+	if err != nil {
+		return "", err
+	}
+	d.offset += n
+	return value.IntValue.String(), nil
+}
+
+func (d *decoder) hash(size int, prefix string) (string, error) {
+	value, err := d.bytes(size)
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeBase58(value, []byte(prefix))
+}
+
+// implicit - reads 21-byte public key hash
+func (d *decoder) implicit() (string, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return "", err
+	}
+	var prefix string
+	switch tag {
+	case 0:
+		prefix = encoding.PrefixPublicKeyTZ1
+	case 1:
+		prefix = encoding.PrefixPublicKeyTZ2
+	case 2:
+		prefix = encoding.PrefixPublicKeyTZ3
+	case 3:
+		prefix = encoding.PrefixPublicKeyTZ4
+	default:
+		return "", errors.Errorf("unknown public key hash tag: %d", tag)
+	}
+	return d.hash(20, prefix)
+}
+
+// contract - reads 22-byte contract address
+func (d *decoder) contract() (string, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return "", err
+	}
+	var prefix string
+	switch tag {
+	case 0:
+		return d.implicit()
+	case 1:
+		prefix = encoding.PrefixPublicKeyKT1
+	case 3:
+		prefix = encoding.PrefixSmartRollupAddress
+	default:
+		return "", errors.Errorf("unknown contract tag: %d", tag)
+	}
+	address, err := d.hash(20, prefix)
+	if err != nil {
+		return "", err
+	}
+	if padding, err := d.byte(); err != nil {
+		return "", err
+	} else if padding != 0 {
+		return "", errors.Errorf("invalid contract padding: %d", padding)
+	}
+	return address, nil
+}
+
+func (d *decoder) publicKey() (string, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return "", err
+	}
+	switch tag {
+	case 0:
+		return d.hash(32, encoding.PrefixED25519PublicKey)
+	case 1:
+		return d.hash(33, encoding.PrefixSecp256k1PublicKey)
+	case 2:
+		return d.hash(33, encoding.PrefixP256PublicKey)
+	case 3:
+		return d.hash(48, encoding.PrefixBLS12381PublicKey)
+	default:
+		return "", errors.Errorf("unknown public key tag: %d", tag)
+	}
+}
+
+func (d *decoder) optionalImplicit() (string, error) {
+	ok, err := d.bool()
+	if err != nil || !ok {
+		return "", err
+	}
+	return d.implicit()
+}
+
+func (d *decoder) optionalProof() (string, error) {
+	ok, err := d.bool()
+	if err != nil || !ok {
+		return "", err
+	}
+	proof, err := d.dynamic()
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeBase58(proof, []byte(encoding.PrefixBLS12381Signature))
+}
+
+// micheline - reads micheline expression with 4-byte length prefix and returns it in JSON
+func (d *decoder) micheline() (stdJSON.RawMessage, error) {
+	value, err := d.dynamic()
+	if err != nil {
+		return nil, err
+	}
+	unforger := NewMichelson()
+	n, err := unforger.Unforge(value)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(value) || len(unforger.Nodes) != 1 {
+		return nil, errors.Errorf("invalid micheline expression: %x", value)
+	}
+	return json.Marshal(unforger.Nodes[0])
+}
+
+func (d *decoder) entrypoint() (string, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return "", err
+	}
+	if tag != 255 {
+		for name, value := range entrypointTags {
+			if value == tag {
+				return name, nil
+			}
+		}
+		return "", errors.Errorf("unknown entrypoint tag: %d", tag)
+	}
+	size, err := d.byte()
+	if err != nil {
+		return "", err
+	}
+	name, err := d.bytes(int(size))
+	if err != nil {
+		return "", err
+	}
+	return string(name), nil
+}
+
+func (d *decoder) manager(kind string) (op managerOperation, err error) {
+	op.Kind = kind
+	if op.Source, err = d.implicit(); err != nil {
+		return op, errors.Wrap(err, "source unforging")
+	}
+	if op.Fee, err = d.nat(); err != nil {
+		return op, errors.Wrap(err, "fee unforging")
+	}
+	if op.Counter, err = d.nat(); err != nil {
+		return op, errors.Wrap(err, "counter unforging")
+	}
+	if op.GasLimit, err = d.nat(); err != nil {
+		return op, errors.Wrap(err, "gas limit unforging")
+	}
+	if op.StorageLimit, err = d.nat(); err != nil {
+		return op, errors.Wrap(err, "storage limit unforging")
+	}
+	return op, nil
+}
+
+func (d *decoder) operation() (node.Operation, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return node.Operation{}, err
+	}
+	var kind string
+	for name, value := range operationTags {
+		if value == tag {
+			kind = name
+			break
+		}
+	}
+
+	var body any
+	switch kind {
+	case node.KindEndorsement:
+		body, err = d.endorsement()
+	case node.KindAttestation:
+		body, err = d.attestation()
+	case node.KindPreattestation:
+		body, err = d.preattestation()
+	case node.KindAttestationWithDal:
+		body, err = d.attestationWithDal(kind)
+	case node.KindNonceRevelation:
+		body, err = d.seedNonceRevelation()
+	case node.KindActivation:
+		body, err = d.activateAccount()
+	case node.KindProposal:
+		body, err = d.proposals()
+	case node.KindBallot:
+		body, err = d.ballot()
+	case node.KindDoubleAttestation:
+		body, err = d.doubleAttestationEvidence()
+	case node.KindDoublePreattestation:
+		body, err = d.doublePreattestationEvidence()
+	case node.KindDoubleBaking:
+		body, err = d.doubleBakingEvidence()
+	case "":
+		return node.Operation{}, errors.Errorf("unknown operation tag: %d", tag)
+	default:
+		body, err = d.managerOperation(kind)
+	}
+	if err != nil {
+		return node.Operation{}, errors.Wrapf(err, "%s unforging", kind)
+	}
+	return node.Operation{
+		Kind: kind,
+		Body: body,
+	}, nil
+}
+
+func (d *decoder) managerOperation(kind string) (any, error) {
+	var decode func(managerOperation) (any, error)
+	switch kind {
+	case node.KindTransaction:
+		decode = decodeBody(d.transaction)
+	case node.KindReveal:
+		decode = decodeBody(d.reveal)
+	case node.KindDelegation:
+		decode = decodeBody(d.delegation)
+	case node.KindOrigination:
+		decode = decodeBody(d.origination)
+	case node.KindRegisterGlobalConstant:
+		decode = decodeBody(d.registerGlobalConstant)
+	case node.KindSetDepositsLimit:
+		decode = decodeBody(d.setDepositsLimit)
+	case node.KindIncreasePaidStorage:
+		decode = decodeBody(d.increasePaidStorage)
+	case node.KindTransferTicket:
+		decode = decodeBody(d.transferTicket)
+	case node.KindUpdateConsensusKey:
+		decode = decodeBody(d.updateConsensusKey)
+	case node.KindSrOriginate:
+		decode = decodeBody(d.smartRollupOriginate)
+	case node.KindSrAddMessages:
+		decode = decodeBody(d.smartRollupAddMessages)
+	case node.KindSrCement:
+		decode = decodeBody(d.smartRollupCement)
+	case node.KindSrPublish:
+		decode = decodeBody(d.smartRollupPublish)
+	case node.KindSrRefute:
+		decode = decodeBody(d.smartRollupRefute)
+	case node.KindSrTimeout:
+		decode = decodeBody(d.smartRollupTimeout)
+	case node.KindSrExecute:
+		decode = decodeBody(d.smartRollupExecute)
+	case node.KindSrRecoverBond:
+		decode = decodeBody(d.smartRollupRecoverBond)
+	case node.KindDalPublishCommitment:
+		decode = decodeBody(d.dalPublishCommitment)
+	default:
+		return nil, errors.Errorf("unsupported operation kind: %s", kind)
+	}
+
+	header, err := d.manager(kind)
+	if err != nil {
+		return nil, err
+	}
+	return decode(header)
+}
+
+func decodeBody[T any](decode func(managerOperation) (T, error)) func(managerOperation) (any, error) {
+	return func(m managerOperation) (any, error) {
+		return decode(m)
+	}
+}
+
+func (d *decoder) transaction(m managerOperation) (tx node.Transaction, err error) {
+	tx.Kind, tx.Source, tx.Fee, tx.Counter, tx.GasLimit, tx.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if tx.Amount, err = d.nat(); err != nil {
+		return
+	}
+	if tx.Destination, err = d.contract(); err != nil {
+		return
+	}
+	hasParams, err := d.bool()
+	if err != nil || !hasParams {
+		return
+	}
+	entrypoint, err := d.entrypoint()
+	if err != nil {
+		return
+	}
+	value, err := d.micheline()
+	if err != nil {
+		return
+	}
+	tx.Parameters = &node.Parameters{
+		Entrypoint: entrypoint,
+		Value:      &value,
+	}
+	return
+}
+
+func (d *decoder) reveal(m managerOperation) (reveal node.Reveal, err error) {
+	reveal.Kind, reveal.Source, reveal.Fee, reveal.Counter, reveal.GasLimit, reveal.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if reveal.PublicKey, err = d.publicKey(); err != nil {
+		return
+	}
+	reveal.Proof, err = d.optionalProof()
+	return
+}
+
+func (d *decoder) delegation(m managerOperation) (delegation node.Delegation, err error) {
+	delegation.Kind, delegation.Source, delegation.Fee, delegation.Counter, delegation.GasLimit, delegation.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	delegation.Delegate, err = d.optionalImplicit()
+	return
+}
+
+func (d *decoder) origination(m managerOperation) (origination node.Origination, err error) {
+	origination.Kind, origination.Source, origination.Fee, origination.Counter, origination.GasLimit, origination.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if origination.Balance, err = d.nat(); err != nil {
+		return
+	}
+	if origination.Delegate, err = d.optionalImplicit(); err != nil {
+		return
+	}
+	var script struct {
+		Code    stdJSON.RawMessage `json:"code"`
+		Storage stdJSON.RawMessage `json:"storage"`
+	}
+	if script.Code, err = d.micheline(); err != nil {
+		return
+	}
+	if script.Storage, err = d.micheline(); err != nil {
+		return
+	}
+	origination.Script, err = json.Marshal(script)
+	return
+}
+
+func (d *decoder) registerGlobalConstant(m managerOperation) (constant node.RegisterGlobalConstant, err error) {
+	constant.Kind, constant.Source, constant.Fee, constant.Counter, constant.GasLimit, constant.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	constant.Value, err = d.micheline()
+	return
+}
+
+func (d *decoder) setDepositsLimit(m managerOperation) (limit node.SetDepositsLimit, err error) {
+	limit.Kind, limit.Source, limit.Fee, limit.Counter, limit.GasLimit, limit.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	hasLimit, err := d.bool()
+	if err != nil || !hasLimit {
+		return
+	}
+	value, err := d.nat()
+	if err != nil {
+		return
+	}
+	limit.Limit = &value
+	return
+}
+
+func (d *decoder) increasePaidStorage(m managerOperation) (increase node.IncreasePaidStorage, err error) {
+	increase.Kind, increase.Source, increase.Fee, increase.Counter, increase.GasLimit, increase.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if increase.Amount, err = d.int(); err != nil {
+		return
+	}
+	increase.Destination, err = d.contract()
+	return
+}
+
+func (d *decoder) transferTicket(m managerOperation) (transfer node.TransferTicket, err error) {
+	transfer.Kind, transfer.Source, transfer.Fee, transfer.Counter, transfer.GasLimit, transfer.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if transfer.TicketContents, err = d.micheline(); err != nil {
+		return
+	}
+	if transfer.TicketType, err = d.micheline(); err != nil {
+		return
+	}
+	if transfer.TicketTicketer, err = d.contract(); err != nil {
+		return
+	}
+	if transfer.TicketAmount, err = d.nat(); err != nil {
+		return
+	}
+	if transfer.Destination, err = d.contract(); err != nil {
+		return
+	}
+	entrypoint, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	transfer.Entrypoint = string(entrypoint)
+	return
+}
+
+func (d *decoder) updateConsensusKey(m managerOperation) (update node.UpdateConsensusKey, err error) {
+	update.Kind, update.Source, update.Fee, update.Counter, update.GasLimit, update.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if update.Pk, err = d.publicKey(); err != nil {
+		return
+	}
+	update.Proof, err = d.optionalProof()
+	return
+}
+
+func (d *decoder) smartRollupOriginate(m managerOperation) (originate node.SmartRollupOriginate, err error) {
+	originate.Kind, originate.Source, originate.Fee, originate.Counter, originate.GasLimit, originate.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	pvmKind, err := d.byte()
+	if err != nil {
+		return
+	}
+	for kind, tag := range pvmKindTags {
+		if tag == pvmKind {
+			originate.PvmKind = kind
+		}
+	}
+	if originate.PvmKind == "" {
+		return originate, errors.Errorf("unknown pvm kind: %d", pvmKind)
+	}
+	kernel, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	originate.Kernel = hex.EncodeToString(kernel)
+	if originate.ParametersTy, err = d.micheline(); err != nil {
+		return
+	}
+	hasWhitelist, err := d.bool()
+	if err != nil || !hasWhitelist {
+		return
+	}
+	whitelist, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	list := &decoder{data: whitelist}
+	for list.offset < len(list.data) {
+		address, err := list.implicit()
+		if err != nil {
+			return originate, errors.Wrap(err, "whitelist unforging")
+		}
+		originate.Whitelist = append(originate.Whitelist, address)
+	}
+	return
+}
+
+func (d *decoder) smartRollupAddMessages(m managerOperation) (add node.SmartRollupAddMessage, err error) {
+	add.Kind, add.Source, add.Fee, add.Counter, add.GasLimit, add.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	messages, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	list := &decoder{data: messages}
+	add.Message = make([]string, 0)
+	for list.offset < len(list.data) {
+		message, err := list.dynamic()
+		if err != nil {
+			return add, errors.Wrap(err, "message unforging")
+		}
+		add.Message = append(add.Message, hex.EncodeToString(message))
+	}
+	return
+}
+
+func (d *decoder) smartRollupCement(m managerOperation) (cement node.SmartRollupCement, err error) {
+	cement.Kind, cement.Source, cement.Fee, cement.Counter, cement.GasLimit, cement.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	cement.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress)
+	return
+}
+
+func (d *decoder) smartRollupPublish(m managerOperation) (publish node.SmartRollupPublish, err error) {
+	publish.Kind, publish.Source, publish.Fee, publish.Counter, publish.GasLimit, publish.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if publish.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress); err != nil {
+		return
+	}
+	if publish.Commitment.CompressedState, err = d.hash(32, encoding.PrefixSmartRollupStateHash); err != nil {
+		return
+	}
+	inboxLevel, err := d.uint32()
+	if err != nil {
+		return
+	}
+	publish.Commitment.InboxLevel = uint64(inboxLevel)
+	if publish.Commitment.Predecessor, err = d.hash(32, encoding.PrefixSmartRollupCommitmentHash); err != nil {
+		return
+	}
+	ticks, err := d.uint64()
+	if err != nil {
+		return
+	}
+	publish.Commitment.NumberOfTicks = new(big.Int).SetUint64(ticks).String()
+	return
+}
+
+func (d *decoder) smartRollupRefute(m managerOperation) (refute node.SmartRollupRefute, err error) {
+	refute.Kind, refute.Source, refute.Fee, refute.Counter, refute.GasLimit, refute.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if refute.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress); err != nil {
+		return
+	}
+	if refute.Opponent, err = d.implicit(); err != nil {
+		return
+	}
+	refutationKind, err := d.byte()
+	if err != nil {
+		return
+	}
+	if refutationKind != 0 {
+		return refute, errors.Errorf("unsupported refutation kind: %d", refutationKind)
+	}
+	refute.Refutation.RefutationKind = RefutationKindStart
+	if refute.Refutation.PlayerCommitmentHash, err = d.hash(32, encoding.PrefixSmartRollupCommitmentHash); err != nil {
+		return
+	}
+	refute.Refutation.OpponentCommitmentHash, err = d.hash(32, encoding.PrefixSmartRollupCommitmentHash)
+	return
+}
+
+func (d *decoder) smartRollupTimeout(m managerOperation) (timeout node.SmartRollupTimeout, err error) {
+	timeout.Kind, timeout.Source, timeout.Fee, timeout.Counter, timeout.GasLimit, timeout.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if timeout.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress); err != nil {
+		return
+	}
+	if timeout.Stakers.Alice, err = d.implicit(); err != nil {
+		return
+	}
+	timeout.Stakers.Bob, err = d.implicit()
+	return
+}
+
+func (d *decoder) smartRollupExecute(m managerOperation) (execute node.SmartRollupExecute, err error) {
+	execute.Kind, execute.Source, execute.Fee, execute.Counter, execute.GasLimit, execute.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if execute.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress); err != nil {
+		return
+	}
+	if execute.CementedCommitment, err = d.hash(32, encoding.PrefixSmartRollupCommitmentHash); err != nil {
+		return
+	}
+	proof, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	execute.OutputProof = hex.EncodeToString(proof)
+	return
+}
+
+func (d *decoder) smartRollupRecoverBond(m managerOperation) (bond node.SmartRollupRecoverBond, err error) {
+	bond.Kind, bond.Source, bond.Fee, bond.Counter, bond.GasLimit, bond.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	if bond.Rollup, err = d.hash(20, encoding.PrefixSmartRollupAddress); err != nil {
+		return
+	}
+	bond.Staker, err = d.implicit()
+	return
+}
+
+func (d *decoder) dalPublishCommitment(m managerOperation) (publish node.DalPublishCommitment, err error) {
+	publish.Kind, publish.Source, publish.Fee, publish.Counter, publish.GasLimit, publish.StorageLimit = m.Kind, m.Source, m.Fee, m.Counter, m.GasLimit, m.StorageLimit
+	slotIndex, err := d.byte()
+	if err != nil {
+		return
+	}
+	publish.SlotHeader.SlotIndex = int(slotIndex)
+	if publish.SlotHeader.Commitment, err = d.hash(48, encoding.PrefixDalCommitment); err != nil {
+		return
+	}
+	proof, err := d.bytes(dalCommitmentProofSize)
+	if err != nil {
+		return
+	}
+	publish.SlotHeader.CommitmentProof = hex.EncodeToString(proof)
+	return
+}
+
+func (d *decoder) endorsement() (endorsement node.Endorsement, err error) {
+	level, err := d.uint32()
+	if err != nil {
+		return
+	}
+	endorsement.Level = uint64(level)
+	return
+}
+
+// attestation - reads consensus content: slot, level, round and block payload hash
+func (d *decoder) attestation() (attestation node.Endorsement, err error) {
+	slot, err := d.bytes(2)
+	if err != nil {
+		return
+	}
+	attestation.Slot = uint64(binary.BigEndian.Uint16(slot))
+	level, err := d.uint32()
+	if err != nil {
+		return
+	}
+	attestation.Level = uint64(level)
+	round, err := d.uint32()
+	if err != nil {
+		return
+	}
+	attestation.Round = int64(int32(round))
+	attestation.BlockPayloadHash, err = d.hash(32, encoding.PrefixBlockPayloadHash)
+	return
+}
+
+func (d *decoder) preattestation() (node.Preendorsement, error) {
+	attestation, err := d.attestation()
+	return node.Preendorsement{
+		Slot:             attestation.Slot,
+		Level:            attestation.Level,
+		Round:            attestation.Round,
+		BlockPayloadHash: attestation.BlockPayloadHash,
+	}, err
+}
+
+func (d *decoder) attestationWithDal(kind string) (attestation node.EndorsementWithDal, err error) {
+	content, err := d.attestation()
+	if err != nil {
+		return
+	}
+	attestation.Kind, attestation.Slot, attestation.Level, attestation.Round, attestation.BlockPayloadHash = kind, content.Slot, content.Level, content.Round, content.BlockPayloadHash
+	attestation.DalAttestation, err = d.nat()
+	return
+}
+
+func (d *decoder) seedNonceRevelation() (revelation node.SeedNonceRevelation, err error) {
+	level, err := d.uint32()
+	if err != nil {
+		return
+	}
+	revelation.Level = uint64(level)
+	nonce, err := d.bytes(32)
+	if err != nil {
+		return
+	}
+	revelation.Nonce = hex.EncodeToString(nonce)
+	return
+}
+
+func (d *decoder) activateAccount() (activation node.AccountActivation, err error) {
+	if activation.Pkh, err = d.hash(20, encoding.PrefixPublicKeyTZ1); err != nil {
+		return
+	}
+	secret, err := d.bytes(20)
+	if err != nil {
+		return
+	}
+	activation.Secret = hex.EncodeToString(secret)
+	return
+}
+
+func (d *decoder) proposals() (proposal node.Proposal, err error) {
+	if proposal.Source, err = d.implicit(); err != nil {
+		return
+	}
+	period, err := d.uint32()
+	if err != nil {
+		return
+	}
+	proposal.Period = uint64(period)
+	proposals, err := d.dynamic()
+	if err != nil {
+		return
+	}
+	list := &decoder{data: proposals}
+	proposal.Proposals = make([]string, 0)
+	for list.offset < len(list.data) {
+		hash, err := list.hash(32, encoding.PrefixProtocolHash)
+		if err != nil {
+			return proposal, errors.Wrap(err, "proposals unforging")
+		}
+		proposal.Proposals = append(proposal.Proposals, hash)
+	}
+	return
+}
+
+func (d *decoder) ballot() (ballot node.Ballot, err error) {
+	if ballot.Source, err = d.implicit(); err != nil {
+		return
+	}
+	period, err := d.uint32()
+	if err != nil {
+		return
+	}
+	ballot.Period = uint64(period)
+	if ballot.Proposal, err = d.hash(32, encoding.PrefixProtocolHash); err != nil {
+		return
+	}
+	value, err := d.byte()
+	if err != nil {
+		return
+	}
+	switch value {
+	case 0:
+		ballot.Ballot = "yay"
+	case 1:
+		ballot.Ballot = "nay"
+	case 2:
+		ballot.Ballot = "pass"
+	default:
+		return ballot, errors.Errorf("unknown ballot: %d", value)
+	}
+	return
+}
+
+func (d *decoder) doubleAttestationEvidence() (evidence node.DoubleEndorsementEvidence, err error) {
+	if evidence.Op1, err = d.inlinedConsensus(node.KindAttestation); err != nil {
+		return
+	}
+	evidence.Op2, err = d.inlinedConsensus(node.KindAttestation)
+	return
+}
+
+func (d *decoder) doublePreattestationEvidence() (evidence node.DoublePreendorsementEvidence, err error) {
+	if evidence.Op1, err = d.inlinedConsensus(node.KindPreattestation); err != nil {
+		return
+	}
+	evidence.Op2, err = d.inlinedConsensus(node.KindPreattestation)
+	return
+}
+
+// inlinedConsensus - reads signed (pre)attestation of double signing evidence. The signature takes the rest of the inlined operation.
+func (d *decoder) inlinedConsensus(kind string) (*node.InlinedEndorsement, error) {
+	data, err := d.dynamic()
+	if err != nil {
+		return nil, err
+	}
+	inlined := &decoder{data: data}
+	branch, err := inlined.hash(branchSize, encoding.PrefixBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := inlined.byte()
+	if err != nil {
+		return nil, err
+	}
+	if tag != operationTags[kind] {
+		return nil, errors.Errorf("invalid inlined %s tag: %d", kind, tag)
+	}
+	content, err := inlined.attestation()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := unforgeSignature(inlined.data[inlined.offset:])
+	if err != nil {
+		return nil, err
+	}
+	return &node.InlinedEndorsement{
+		Branch: branch,
+		Operations: &node.InlinedEndorsementOperations{
+			Kind:             kind,
+			Slot:             content.Slot,
+			Level:            int(content.Level),
+			Round:            content.Round,
+			BlockPayloadHash: content.BlockPayloadHash,
+		},
+		Signature: signature,
+	}, nil
+}
+
+func (d *decoder) doubleBakingEvidence() (evidence node.DoubleBakingEvidence, err error) {
+	if evidence.Bh1, err = d.blockHeader(); err != nil {
+		return
+	}
+	evidence.Bh2, err = d.blockHeader()
+	return
+}
+
+// blockHeader - reads signed block header of double baking evidence. The signature takes the rest of the header.
+func (d *decoder) blockHeader() (*node.Header, error) {
+	data, err := d.dynamic()
+	if err != nil {
+		return nil, err
+	}
+	h := &decoder{data: data}
+	header := new(node.Header)
+
+	level, err := h.uint32()
+	if err != nil {
+		return nil, err
+	}
+	header.Level = uint64(level)
+	proto, err := h.byte()
+	if err != nil {
+		return nil, err
+	}
+	header.Proto = int(proto)
+	if header.Predecessor, err = h.hash(32, encoding.PrefixBlockHash); err != nil {
+		return nil, err
+	}
+	timestamp, err := h.uint64()
+	if err != nil {
+		return nil, err
+	}
+	header.Timestamp = time.Unix(int64(timestamp), 0).UTC()
+	validationPass, err := h.byte()
+	if err != nil {
+		return nil, err
+	}
+	header.ValidationPass = int(validationPass)
+	if header.OperationsHash, err = h.hash(32, encoding.PrefixOperationListListHash); err != nil {
+		return nil, err
+	}
+	fitness, err := h.dynamic()
+	if err != nil {
+		return nil, err
+	}
+	list := &decoder{data: fitness}
+	header.Fitness = make([]string, 0)
+	for list.offset < len(list.data) {
+		part, err := list.dynamic()
+		if err != nil {
+			return nil, errors.Wrap(err, "fitness unforging")
+		}
+		header.Fitness = append(header.Fitness, hex.EncodeToString(part))
+	}
+	if header.Context, err = h.hash(32, encoding.PrefixContextHash); err != nil {
+		return nil, err
+	}
+
+	if header.PayloadHash, err = h.hash(32, encoding.PrefixBlockPayloadHash); err != nil {
+		return nil, err
+	}
+	round, err := h.uint32()
+	if err != nil {
+		return nil, err
+	}
+	header.PayloadRound = int(int32(round))
+	nonce, err := h.bytes(8)
+	if err != nil {
+		return nil, err
+	}
+	header.ProofOfWorkNonce = hex.EncodeToString(nonce)
+	if hasSeedNonceHash, err := h.bool(); err != nil {
+		return nil, err
+	} else if hasSeedNonceHash {
+		if header.SeedNonceHash, err = h.hash(32, encoding.PrefixNonceHash); err != nil {
+			return nil, err
+		}
+	}
+	votes, err := h.byte()
+	if err != nil {
+		return nil, err
+	}
+	if header.LiquidityBakingToggleVote, err = perBlockVote(votes & 0x03); err != nil {
+		return nil, err
+	}
+	if header.AdaptiveIssuanceVote, err = perBlockVote(votes >> 2 & 0x03); err != nil {
+		return nil, err
+	}
+	if header.Signature, err = unforgeSignature(h.data[h.offset:]); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func perBlockVote(value byte) (string, error) {
+	switch value {
+	case 0:
+		return "on", nil
+	case 1:
+		return "off", nil
+	case 2:
+		return "pass", nil
+	default:
+		return "", errors.Errorf("unknown per block vote: %d", value)
+	}
+}
+
+// unforgeSignature - encodes signature by its size: 64 bytes for tz1/tz2/tz3 signers and 96 bytes for tz4 signers
+func unforgeSignature(signature []byte) (string, error) {
+	switch len(signature) {
+	case genericSignatureSize:
+		return encoding.EncodeBase58(signature, []byte(encoding.PrefixGenericSignature))
+	case blsSignatureSize:
+		return encoding.EncodeBase58(signature, []byte(encoding.PrefixBLS12381Signature))
+	default:
+		return "", errors.Errorf("invalid signature size: %d", len(signature))
+	}
+}
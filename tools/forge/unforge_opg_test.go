@@ -0,0 +1,371 @@
+package forge
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnforgeOperation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		kind    string
+		wantErr bool
+	}{
+		{
+			name: "transaction with parameters",
+			data: "6c00490dc9520ec45270f240a3cc4f07aec76adc358d9617b693089fcd01000001fcc0bee1480bfca3a80481904cee4099400b1c8d00ff020000004f020000004a0358053d036d0743035d0100000024747a324c324875686161536e663653684544646854454172356a475057504e7770766342031e0743036a0002034f034d031b051f02000000020320",
+			kind: node.KindTransaction,
+		}, {
+			name: "reveal",
+			data: "6b00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f3400",
+			kind: node.KindReveal,
+		}, {
+			name: "reveal secp256k1",
+			data: "6b00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020103682c3aaa998fd9adfe8111cd42cc0daedb5d97647e6020eb629fbc91b613f72100",
+			kind: node.KindReveal,
+		}, {
+			name: "delegation",
+			data: "6e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102ff0052b07c560835c0830a2fd343169b901921a492fe",
+			kind: node.KindDelegation,
+		}, {
+			name: "undelegation",
+			data: "6e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200",
+			kind: node.KindDelegation,
+		}, {
+			name: "origination",
+			data: "6d00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102c0843d000000001c02000000170500036c05010362050202000000080317053d036d034200000002002a",
+			kind: node.KindOrigination,
+		}, {
+			name: "register_global_constant",
+			data: "6f00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000c070700010100000003616263",
+			kind: node.KindRegisterGlobalConstant,
+		}, {
+			name: "set_deposits_limit",
+			data: "7000e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102ffc0843d",
+			kind: node.KindSetDepositsLimit,
+		}, {
+			name: "increase_paid_storage",
+			data: "7100e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102a40101c756189bc655cc487d57e5fefe482449dbe00c3900",
+			kind: node.KindIncreasePaidStorage,
+		}, {
+			name: "transfer_ticket",
+			data: "9e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000a010000000568656c6c6f00000002036801c756189bc655cc487d57e5fefe482449dbe00c3900ac0201fcc0bee1480bfca3a80481904cee4099400b1c8d000000000772656365697665",
+			kind: node.KindTransferTicket,
+		}, {
+			name: "update_consensus_key",
+			data: "7200e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f3400",
+			kind: node.KindUpdateConsensusKey,
+		}, {
+			name: "smart_rollup_add_messages",
+			data: "c900e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b81020000000b00000002010200000001ff",
+			kind: node.KindSrAddMessages,
+		}, {
+			name: "smart_rollup_cement",
+			data: "ca00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf",
+			kind: node.KindSrCement,
+		}, {
+			name: "smart_rollup_recover_bond",
+			data: "cf00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0052b07c560835c0830a2fd343169b901921a492fe",
+			kind: node.KindSrRecoverBond,
+		}, {
+			name: "smart_rollup_timeout",
+			data: "cd00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810274f8952e7a287d78e8dceec67547bd00a278abbf0052b07c560835c0830a2fd343169b901921a492fe00e8b36c80efb51ec85a14562426049aa182a3ce38",
+			kind: node.KindSrTimeout,
+		}, {
+			name: "smart_rollup_originate",
+			data: "c800e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810201000000020a0b00000002036900",
+			kind: node.KindSrOriginate,
+		}, {
+			name:    "truncated",
+			data:    "6e00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b8102ff0052b07c56",
+			wantErr: true,
+		}, {
+			name:    "truncated ballot",
+			data:    "0600e8b36c80efb51ec85a14562426049aa182a3ce38",
+			wantErr: true,
+		}, {
+			name:    "unknown tag",
+			data:    "fe00e8b36c80efb51ec85a14562426049aa182a3ce38",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+
+			operation, n, err := UnforgeOperation(data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(data), n)
+			require.Equal(t, tt.kind, operation.Kind)
+
+			forged, err := Operation(operation)
+			require.NoError(t, err)
+			require.Equal(t, tt.data, hex.EncodeToString(forged))
+		})
+	}
+}
+
+func TestUnforgeOPG(t *testing.T) {
+	const (
+		branch      = "5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750"
+		transaction = "6c00e8b36c80efb51ec85a14562426049aa182a3ce38df02828018f10b64e8070000e8b36c80efb51ec85a14562426049aa182a3ce3800"
+		reveal      = "6b00e8b36c80efb51ec85a14562426049aa182a3ce38d209828018f10b810200fc5e1527bd3d6a5b812f6942aa17f178d71a81fd5765927213066d9c2ad59f3400"
+	)
+	signature := strings.Repeat("ab", genericSignatureSize)
+	blsSignature := strings.Repeat("cd", blsSignatureSize)
+
+	tests := []struct {
+		name          string
+		data          string
+		signatureSize int
+		wantBranch    string
+		wantKinds     []string
+		wantSignature string
+		wantErr       bool
+	}{
+		{
+			name:       "unsigned",
+			data:       branch + reveal + transaction,
+			wantBranch: "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+			wantKinds:  []string{node.KindReveal, node.KindTransaction},
+		}, {
+			name:          "signed",
+			data:          branch + reveal + transaction + signature,
+			signatureSize: genericSignatureSize,
+			wantBranch:    "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+			wantKinds:     []string{node.KindReveal, node.KindTransaction},
+			wantSignature: "sigkSwUrbjgRpK7MsEegGBirWapYepUuU8dEZjNzyzXcxLhGiPnBt8C1X3VmefpVqPnbig2NFoBQFLtLDNqUUKzeoV786aTo",
+		}, {
+			name:          "signed with BLS",
+			data:          branch + transaction + blsSignature,
+			signatureSize: blsSignatureSize,
+			wantBranch:    "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL",
+			wantKinds:     []string{node.KindTransaction},
+			wantSignature: "BLsigCXh18CoWQigZvLy8DbV2y3xzBTw41nDKXPNq1nq1Q58jj7SagVbeFVc81dToWYSCeKED7wBPZCvircHM4a3Ene3xdSY3AsUfHF58QTCSACjiJ9DDsf2jw5XW5sVyyzLcYY5mYAjt9",
+		}, {
+			name:    "signed group without signature size",
+			data:    branch + reveal + transaction + signature,
+			wantErr: true,
+		}, {
+			name:          "unsigned group with signature size",
+			data:          branch + reveal + transaction,
+			signatureSize: genericSignatureSize,
+			wantErr:       true,
+		}, {
+			name:          "invalid signature size",
+			data:          branch + transaction + signature,
+			signatureSize: 32,
+			wantErr:       true,
+		}, {
+			name:    "too short",
+			data:    branch[:20],
+			wantErr: true,
+		}, {
+			name:    "invalid operation",
+			data:    branch + "fe00e8b36c80efb51ec85a14562426049aa182a3ce38",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+
+			group, err := UnforgeOPG(data, tt.signatureSize)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantBranch, group.Branch)
+			require.Len(t, group.Contents, len(tt.wantKinds))
+			for i := range tt.wantKinds {
+				require.Equal(t, tt.wantKinds[i], group.Contents[i].Kind)
+			}
+			require.Equal(t, tt.wantSignature, group.Signature)
+
+			forged, err := OPG(group.Branch, group.Contents...)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(tt.data, hex.EncodeToString(forged)))
+		})
+	}
+}
+
+func TestUnforgeOperation_NonManager(t *testing.T) {
+	const (
+		payloadHash = "vh1oe4NZJbaDhZeLvYLTXkon9hQUpypQZQDnxE9P9T3c95ofWRdm"
+		branch      = "BLRYV1w71DtjyDU27e2XWZ2KyfcGupo985qvphm7PSCNZXk6SHL"
+		proposal    = "PryHZRrQaceGNhdLCuK2F2gb9eJLQ5z4aaDVbuZL86cxMBi7bqg"
+	)
+	header := func(round int, seedNonceHash, signature string) *node.Header {
+		return &node.Header{
+			Level:                     100000,
+			Proto:                     7,
+			Predecessor:               "BL5wtBStYzKEkmwqNWDMLTrkTkyW9JmjAAAxqA5YrZr1kJDedLZ",
+			Timestamp:                 time.Unix(1700000000, 0).UTC(),
+			ValidationPass:            4,
+			OperationsHash:            "LLoZhpCpQpj4UphPCt3zXsRcoh1oK1KzsUj7TG8kxSkCLEWZefvMb",
+			Fitness:                   []string{"02", "000186a0", "", fmt.Sprintf("%08x", round), "00000000"},
+			Context:                   "CoV2rh4GntvnVYGFBa3zLM9uiwo1PxJ6HeXoegcJZcsZVrUUqBXo",
+			PayloadHash:               payloadHash,
+			PayloadRound:              round,
+			ProofOfWorkNonce:          "0102030405060708",
+			SeedNonceHash:             seedNonceHash,
+			LiquidityBakingToggleVote: "off",
+			AdaptiveIssuanceVote:      "pass",
+			Signature:                 signature,
+		}
+	}
+	inlined := func(kind string, slot uint64, signature string) *node.InlinedEndorsement {
+		return &node.InlinedEndorsement{
+			Branch: branch,
+			Operations: &node.InlinedEndorsementOperations{
+				Kind:             kind,
+				Slot:             slot,
+				Level:            100000,
+				BlockPayloadHash: payloadHash,
+			},
+			Signature: signature,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		want    node.Operation
+		wantErr bool
+	}{
+		{
+			name: "attestation",
+			data: "150005000186a0000000011111111111111111111111111111111111111111111111111111111111111111",
+			want: node.Operation{
+				Kind: node.KindAttestation,
+				Body: node.Endorsement{Slot: 5, Level: 100000, Round: 1, BlockPayloadHash: payloadHash},
+			},
+		}, {
+			name: "preattestation",
+			data: "140005000186a0000000001111111111111111111111111111111111111111111111111111111111111111",
+			want: node.Operation{
+				Kind: node.KindPreattestation,
+				Body: node.Preendorsement{Slot: 5, Level: 100000, BlockPayloadHash: payloadHash},
+			},
+		}, {
+			name: "attestation with DAL",
+			data: "170005000186a0000000011111111111111111111111111111111111111111111111111111111111111111ac02",
+			want: node.Operation{
+				Kind: node.KindAttestationWithDal,
+				Body: node.EndorsementWithDal{Kind: node.KindAttestationWithDal, Slot: 5, Level: 100000, Round: 1, BlockPayloadHash: payloadHash, DalAttestation: "300"},
+			},
+		}, {
+			name: "seed_nonce_revelation",
+			data: "01000010004242424242424242424242424242424242424242424242424242424242424242",
+			want: node.Operation{
+				Kind: node.KindNonceRevelation,
+				Body: node.SeedNonceRevelation{Level: 4096, Nonce: "4242424242424242424242424242424242424242424242424242424242424242"},
+			},
+		}, {
+			name: "activate_account",
+			data: "0401010101010101010101010101010101010101015e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e",
+			want: node.Operation{
+				Kind: node.KindActivation,
+				Body: node.AccountActivation{Pkh: "tz1KjLa4hxghcRgtK6i8BgPTXathEV66JaSk", Secret: "5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e5e"},
+			},
+		}, {
+			name: "proposals",
+			data: "0500e8b36c80efb51ec85a14562426049aa182a3ce38000000780000004021212121212121212121212121212121212121212121212121212121212121212222222222222222222222222222222222222222222222222222222222222222",
+			want: node.Operation{
+				Kind: node.KindProposal,
+				Body: node.Proposal{Source: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm", Period: 120, Proposals: []string{proposal, "PryjCmhBd5okB8c6igFXMceT8wcShkMdkzF6t7RJHCEDDkh6tWX"}},
+			},
+		}, {
+			name: "ballot",
+			data: "0600e8b36c80efb51ec85a14562426049aa182a3ce3800000078212121212121212121212121212121212121212121212121212121212121212102",
+			want: node.Operation{
+				Kind: node.KindBallot,
+				Body: node.Ballot{Source: "tz1grSQDByRpnVs7sPtaprNZRp531ZKz6Jmm", Period: 120, Proposal: proposal, Ballot: "pass"},
+			},
+		}, {
+			name: "double_attestation_evidence",
+			data: "020000008b5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750150001000186a0000000001111111111111111111111111111111111111111111111111111111111111111abababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababab0000008b5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750150002000186a0000000001111111111111111111111111111111111111111111111111111111111111111acacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacac",
+			want: node.Operation{
+				Kind: node.KindDoubleAttestation,
+				Body: node.DoubleEndorsementEvidence{
+					Op1: inlined(node.KindAttestation, 1, "sigkSwUrbjgRpK7MsEegGBirWapYepUuU8dEZjNzyzXcxLhGiPnBt8C1X3VmefpVqPnbig2NFoBQFLtLDNqUUKzeoV786aTo"),
+					Op2: inlined(node.KindAttestation, 2, "sigkaZKASH5QmTV6afCLPWh4EmPkRf7bPgw7oUw7L8pH4gVG7rpQTtovP18ytRmMygJzT1bdxwNtvxKGqaeWaSwz2f6zM6tL"),
+				},
+			},
+		}, {
+			name: "double_preattestation_evidence with BLS signatures",
+			data: "07000000ab5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750140001000186a0000000001111111111111111111111111111111111111111111111111111111111111111cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd000000ab5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750140002000186a0000000001111111111111111111111111111111111111111111111111111111111111111cececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececececece",
+			want: node.Operation{
+				Kind: node.KindDoublePreattestation,
+				Body: node.DoublePreendorsementEvidence{
+					Op1: inlined(node.KindPreattestation, 1, "BLsigCXh18CoWQigZvLy8DbV2y3xzBTw41nDKXPNq1nq1Q58jj7SagVbeFVc81dToWYSCeKED7wBPZCvircHM4a3Ene3xdSY3AsUfHF58QTCSACjiJ9DDsf2jw5XW5sVyyzLcYY5mYAjt9"),
+					Op2: inlined(node.KindPreattestation, 2, "BLsigCZxFjCmvZ7FWxyLQVV6Z9o3tkKbsA5QqZq6duqdtqieQQ3L5warRbqX7PN1M3uQe4fWUEJCfwWAz8ncD7v33zoQmmjY84t1KowtPAgjFUgzNftvAxiNcj8yviMBu9Y5VvbJz9FUDN"),
+				},
+			},
+		}, {
+			name: "double_baking_evidence",
+			data: "0300000121000186a0073131313131313131313131313131313131313131313131313131313131313131000000006553f10004323232323232323232323232323232323232323232323232323232323232323200000021000000010200000004000186a0000000000000000400000000000000040000000033333333333333333333333333333333333333333333333333333333333333331111111111111111111111111111111111111111111111111111111111111111000000000102030405060708ff343434343434343434343434343434343434343434343434343434343434343409abababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababab00000121000186a0073131313131313131313131313131313131313131313131313131313131313131000000006553f10004323232323232323232323232323232323232323232323232323232323232323200000021000000010200000004000186a00000000000000004000000010000000400000000333333333333333333333333333333333333333333333333333333333333333311111111111111111111111111111111111111111111111111111111111111110000000101020304050607080009cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd",
+			want: node.Operation{
+				Kind: node.KindDoubleBaking,
+				Body: node.DoubleBakingEvidence{
+					Bh1: header(0, "nceUcwWFVdUvg4qtoVzCpViYoVhjUbajS42AMvZ3B3r4hTf7qRCZj", "sigkSwUrbjgRpK7MsEegGBirWapYepUuU8dEZjNzyzXcxLhGiPnBt8C1X3VmefpVqPnbig2NFoBQFLtLDNqUUKzeoV786aTo"),
+					Bh2: header(1, "", "BLsigCXh18CoWQigZvLy8DbV2y3xzBTw41nDKXPNq1nq1Q58jj7SagVbeFVc81dToWYSCeKED7wBPZCvircHM4a3Ene3xdSY3AsUfHF58QTCSACjiJ9DDsf2jw5XW5sVyyzLcYY5mYAjt9"),
+				},
+			},
+		}, {
+			name:    "invalid ballot",
+			data:    "0600e8b36c80efb51ec85a14562426049aa182a3ce3800000078212121212121212121212121212121212121212121212121212121212121212105",
+			wantErr: true,
+		}, {
+			name:    "preattestation inlined into double attestation evidence",
+			data:    "020000008b5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750140001000186a0000000001111111111111111111111111111111111111111111111111111111111111111abababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababababab0000008b5db044c1a354b21ef464a61febad3c4efc910588e8f9400d82a64626966af750150002000186a0000000001111111111111111111111111111111111111111111111111111111111111111acacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacacac",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+
+			operation, n, err := UnforgeOperation(data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(data), n)
+			require.Equal(t, tt.want, operation)
+		})
+	}
+}
+
+func TestUnforgeOPG_Endorsement(t *testing.T) {
+	const branch = "BMbpxQAU7Jat7g9ZnKrP3brgqFX6r2VX8PPXCxNbFZeURA6DbEF"
+
+	data, err := Endorsement(node.Endorsement{Level: 751292}, branch)
+	require.NoError(t, err)
+
+	group, err := UnforgeOPG(data, 0)
+	require.NoError(t, err)
+	require.Equal(t, branch, group.Branch)
+	require.Equal(t, []node.Operation{
+		{
+			Kind: node.KindEndorsement,
+			Body: node.Endorsement{Level: 751292},
+		},
+	}, group.Contents)
+}
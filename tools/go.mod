@@ -3,6 +3,8 @@ module github.com/dipdup-io/go-lib/tools
 go 1.26.4
 
 require (
+	github.com/consensys/gnark-crypto v0.19.2
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
 	github.com/dipdup-io/go-lib/node v1.0.1
 	github.com/ebellocchia/go-base58 v0.1.0
 	github.com/json-iterator/go v1.1.12
@@ -10,11 +12,13 @@ require (
 	github.com/sergi/go-diff v1.4.0
 	github.com/stretchr/testify v1.11.1
 	github.com/tidwall/gjson v1.19.0
+	github.com/tyler-smith/go-bip39 v1.1.0
 	github.com/yhirose/go-peg v0.0.0-20210804202551-de25d6753cf1
 	golang.org/x/crypto v0.54.0
 )
 
 require (
+	github.com/bits-and-blooms/bitset v1.20.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/mattn/go-colorable v0.1.15 // indirect
 	github.com/mattn/go-isatty v0.0.22 // indirect
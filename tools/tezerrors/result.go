@@ -0,0 +1,31 @@
+package tezerrors
+
+import (
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/pkg/errors"
+)
+
+// ParseResultErrors - converts errors of node operation result to `Error`
+func ParseResultErrors(resultErrors []node.ResultError) ([]*Error, error) {
+	if len(resultErrors) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*Error, len(resultErrors))
+	for i := range resultErrors {
+		data := []byte(resultErrors[i].Raw)
+		if len(data) == 0 {
+			raw, err := json.Marshal(resultErrors[i])
+			if err != nil {
+				return nil, errors.Wrap(err, "result error marshaling")
+			}
+			data = raw
+		}
+
+		result[i] = new(Error)
+		if err := json.Unmarshal(data, result[i]); err != nil {
+			return nil, errors.Wrapf(err, "result error parsing: %s", resultErrors[i].ID)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package tezerrors
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/go-lib/node"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResultErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []*Error
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: `{"status":"applied"}`,
+		}, {
+			name: "balance too low and script rejected",
+			data: `{"status":"failed","errors":[{"kind":"temporary","id":"proto.004-Pt24m4xi.contract.balance_too_low","contract":"KT1BvVxWM6cjFuJNet4R9m64VDCN2iMvjuGE","balance":"5248650175","amount":"22571025048"},{"kind":"temporary","id":"proto.005-PsBabyM1.michelson_v1.script_rejected","location":226,"with":{"prim":"Unit"}}]}`,
+			want: []*Error{
+				{
+					Kind:        "temporary",
+					ID:          "proto.004-Pt24m4xi.contract.balance_too_low",
+					Title:       "Balance too low",
+					Description: "An operation tried to spend more tokens than the contract has",
+					IError: &BalanceTooLowError{
+						Amount:  22571025048,
+						Balance: 5248650175,
+					},
+				}, {
+					Kind:        "temporary",
+					ID:          "proto.005-PsBabyM1.michelson_v1.script_rejected",
+					Title:       "Script failed",
+					Description: "A FAILWITH instruction was reached",
+					IError: &DefaultError{
+						Location: 226,
+						With:     []byte(`{"prim":"Unit"}`),
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, LoadErrorDescriptions())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result node.OperationResult
+			require.NoError(t, jsoniter.Unmarshal([]byte(tt.data), &result))
+
+			got, err := ParseResultErrors(result.Errors)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
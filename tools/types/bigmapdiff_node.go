@@ -0,0 +1,63 @@
+package types
+
+import (
+	stdJSON "encoding/json"
+	"strconv"
+
+	"github.com/dipdup-io/go-lib/node"
+	"github.com/pkg/errors"
+)
+
+// NewBigMapDiffsFromResult - converts big map updates of node operation result to `BigMapDiff`. `address` is the contract
+// whose storage was changed. Lazy storage diff is used if it's present, otherwise legacy `big_map_diff` is converted.
+// Allocations, copies and removals of whole big maps are skipped because they can't be represented by `BigMapDiff`.
+func NewBigMapDiffsFromResult(address string, result node.OperationResult) ([]BigMapDiff, error) {
+	diffs := make([]BigMapDiff, 0)
+
+	if len(result.LazyStorageDiff) > 0 {
+		for diff, update := range result.BigMapUpdates() {
+			ptr, err := strconv.ParseInt(diff.ID, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid big map id: %s", diff.ID)
+			}
+			diffs = append(diffs, BigMapDiff{
+				Ptr:     ptr,
+				Key:     rawBytes(update.Key),
+				Value:   rawBytes(update.Value),
+				KeyHash: update.KeyHash,
+				Address: address,
+			})
+		}
+		return diffs, nil
+	}
+
+	for i := range result.BigMapDiff {
+		diff := result.BigMapDiff[i]
+		if diff.Action != "" && diff.Action != node.BigMapActionUpdate {
+			continue
+		}
+		var ptr int64
+		if diff.BigMap != "" {
+			value, err := strconv.ParseInt(diff.BigMap, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid big map id: %s", diff.BigMap)
+			}
+			ptr = value
+		}
+		diffs = append(diffs, BigMapDiff{
+			Ptr:     ptr,
+			Key:     rawBytes(diff.Key),
+			Value:   rawBytes(diff.Value),
+			KeyHash: diff.KeyHash,
+			Address: address,
+		})
+	}
+	return diffs, nil
+}
+
+func rawBytes(value *stdJSON.RawMessage) []byte {
+	if value == nil {
+		return nil
+	}
+	return []byte(*value)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/go-lib/node"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBigMapDiffsFromResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []BigMapDiff
+		wantErr bool
+	}{
+		{
+			name: "lazy storage diff",
+			data: `{"status":"applied","lazy_storage_diff":[{"kind":"big_map","id":"149772","diff":{"action":"update","updates":[{"key_hash":"exprtXkKsYwmh8uaNQhkZLpBNbqiNnLxCzHqCuv9bjNPeMkvAaxFk4","key":{"int":"1"},"value":{"string":"a"}},{"key_hash":"exprvD1v8DhVmAR1G1J7M9aiuNyyLNSnKPMpHrKZDFpZdt8ZuLDEbq","key":{"int":"2"}}]}},{"kind":"sapling_state","id":"14","diff":{"action":"update","updates":{"commitments_and_ciphertexts":[["0b2b5d3e",{"cv":"cv","epk":"epk","payload_enc":"enc","nonce_enc":"nonce","payload_out":"out","nonce_out":"nonce_out"}]],"nullifiers":["aa"]}}}]}`,
+			want: []BigMapDiff{
+				{
+					Ptr:     149772,
+					Key:     []byte(`{"int":"1"}`),
+					Value:   []byte(`{"string":"a"}`),
+					KeyHash: "exprtXkKsYwmh8uaNQhkZLpBNbqiNnLxCzHqCuv9bjNPeMkvAaxFk4",
+					Address: "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn",
+				}, {
+					Ptr:     149772,
+					Key:     []byte(`{"int":"2"}`),
+					KeyHash: "exprvD1v8DhVmAR1G1J7M9aiuNyyLNSnKPMpHrKZDFpZdt8ZuLDEbq",
+					Address: "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn",
+				},
+			},
+		}, {
+			name: "legacy big map diff",
+			data: `{"status":"applied","big_map_diff":[{"action":"alloc","big_map":"17"},{"action":"update","big_map":"17","key_hash":"exprtXkKsYwmh8uaNQhkZLpBNbqiNnLxCzHqCuv9bjNPeMkvAaxFk4","key":{"int":"1"},"value":{"int":"5"}}]}`,
+			want: []BigMapDiff{
+				{
+					Ptr:     17,
+					Key:     []byte(`{"int":"1"}`),
+					Value:   []byte(`{"int":"5"}`),
+					KeyHash: "exprtXkKsYwmh8uaNQhkZLpBNbqiNnLxCzHqCuv9bjNPeMkvAaxFk4",
+					Address: "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn",
+				},
+			},
+		}, {
+			name:    "invalid big map id",
+			data:    `{"status":"applied","lazy_storage_diff":[{"kind":"big_map","id":"abc","diff":{"action":"update","updates":[{"key_hash":"expr","key":{"int":"1"}}]}}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result node.OperationResult
+			require.NoError(t, jsoniter.Unmarshal([]byte(tt.data), &result))
+
+			got, err := NewBigMapDiffsFromResult("KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn", result)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
 )
 
 // AccountCounter - Returns account counter
@@ -14,3 +16,37 @@ func (tzkt *API) AccountCounter(ctx context.Context, address string) (uint64, er
 func (tzkt *API) AccountsCount(ctx context.Context, filters map[string]string) (uint64, error) {
 	return tzkt.count(ctx, "/v1/accounts/count", filters)
 }
+
+// GetAccounts - Returns a list of accounts.
+func (tzkt *API) GetAccounts(ctx context.Context, filters map[string]string) (accounts []data.Account, err error) {
+	err = tzkt.json(ctx, "/v1/accounts", filters, false, &accounts)
+	return
+}
+
+// GetAccount - Returns an account with the specified address.
+func (tzkt *API) GetAccount(ctx context.Context, address string, filters map[string]string) (account data.Account, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/accounts/%s", address), filters, false, &account)
+	return
+}
+
+// GetAccountBalance - Returns the current balance of the account in mutez.
+func (tzkt *API) GetAccountBalance(ctx context.Context, address string) (uint64, error) {
+	return tzkt.count(ctx, fmt.Sprintf("/v1/accounts/%s/balance", address), nil)
+}
+
+// GetAccountBalanceAtLevel - Returns the balance of the account at the end of the block at the specified level.
+func (tzkt *API) GetAccountBalanceAtLevel(ctx context.Context, address string, level uint64) (uint64, error) {
+	return tzkt.count(ctx, fmt.Sprintf("/v1/accounts/%s/balance_history/%d", address, level), nil)
+}
+
+// GetAccountBalanceHistory - Returns time series with historical balances of the account. `step` filter sets the interval of the series in blocks.
+func (tzkt *API) GetAccountBalanceHistory(ctx context.Context, address string, filters map[string]string) (history []data.HistoricalBalance, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/accounts/%s/balance_history", address), filters, false, &history)
+	return
+}
+
+// GetAccountOperations - Returns operations of all types related to the account. Types can be filtered by `type` filter.
+func (tzkt *API) GetAccountOperations(ctx context.Context, address string, filters map[string]string) (operations []data.Operation, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/accounts/%s/operations", address), filters, false, &operations)
+	return
+}
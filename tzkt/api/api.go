@@ -12,10 +12,12 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/dipdup-io/go-lib/config"
 	"github.com/dipdup-io/go-lib/tools/crypto"
 	"github.com/dipdup-io/go-lib/tzkt/data"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -27,6 +29,11 @@ type API struct {
 
 	user       string
 	privateKey string
+	apiKey     *apiKey
+
+	limiter *rate.Limiter
+	retry   retryPolicy
+	cache   *responseCache
 }
 
 // New -
@@ -42,6 +49,9 @@ func New(baseURL string, opts ...Option) *API {
 			Timeout:   time.Minute,
 			Transport: t,
 		},
+		retry: retryPolicy{
+			attempts: 1,
+		},
 	}
 	for i := range opts {
 		opts[i](api)
@@ -50,13 +60,36 @@ func New(baseURL string, opts ...Option) *API {
 	return api
 }
 
-func (tzkt *API) get(ctx context.Context, endpoint string, args map[string]string, withAuth bool) (*http.Response, error) {
+// NewFromDataSource - creates client from data source config. Requests are retried with default parameters,
+// `rps` limits requests per second, `timeout` sets request timeout in seconds. API key of credentials is sent
+// in its header and user credentials are used for signing private requests with user name and private key in password.
+func NewFromDataSource(cfg config.DataSource, opts ...Option) *API {
+	options := []Option{
+		WithDefaultRetry(),
+		WithRateLimit(cfg.RequestsPerSecond),
+	}
+	if cfg.Timeout > 0 {
+		options = append(options, WithTimeout(time.Duration(cfg.Timeout)*time.Second))
+	}
+	if cfg.Credentials != nil {
+		if cfg.Credentials.ApiKey != nil {
+			options = append(options, WithAPIKey(cfg.Credentials.ApiKey.Header, cfg.Credentials.ApiKey.Key))
+		}
+		if cfg.Credentials.User != nil {
+			options = append(options, WithAuth(cfg.Credentials.User.Name, cfg.Credentials.User.Password))
+		}
+	}
+	options = append(options, opts...)
+	return New(cfg.URL, options...)
+}
+
+func (tzkt *API) buildURL(endpoint string, args map[string]string) (string, error) {
 	u, err := url.Parse(tzkt.url)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if u.Scheme != "https" && u.Scheme != "http" {
-		return nil, errors.Errorf("invalid scheme: %s", u.Scheme)
+		return "", errors.Errorf("invalid scheme: %s", u.Scheme)
 	}
 	u.Path = path.Join(u.Path, endpoint)
 
@@ -65,36 +98,46 @@ func (tzkt *API) get(ctx context.Context, endpoint string, args map[string]strin
 		values.Add(key, value)
 	}
 	u.RawQuery = values.Encode()
+	return u.String(), nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+func (tzkt *API) get(ctx context.Context, endpoint string, args map[string]string, withAuth bool) (*http.Response, error) {
+	link, err := tzkt.buildURL(endpoint, args)
 	if err != nil {
 		return nil, err
 	}
 
-	if withAuth {
-		if err := tzkt.auth(req); err != nil {
-			return nil, err
+	if tzkt.cache != nil && !withAuth {
+		if level, ok := cacheableLevel(endpoint, args); ok && tzkt.isConfirmed(ctx, level) {
+			return tzkt.cached(ctx, link)
 		}
 	}
 
-	return tzkt.client.Do(req) //nolint:gosec
+	return tzkt.getURL(ctx, link, withAuth)
+}
+
+func (tzkt *API) getURL(ctx context.Context, link string, withAuth bool) (*http.Response, error) {
+	return tzkt.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// request is signed on every attempt because signature contains nonce
+		if withAuth {
+			if err := tzkt.auth(req); err != nil {
+				return nil, err
+			}
+		}
+		return req, nil
+	})
 }
 
 func (tzkt *API) post(ctx context.Context, endpoint string, args map[string]string, body interface{}) (*http.Response, error) {
-	u, err := url.Parse(tzkt.url)
+	link, err := tzkt.buildURL(endpoint, args)
 	if err != nil {
 		return nil, err
 	}
-	if u.Scheme != "https" && u.Scheme != "http" {
-		return nil, errors.Errorf("invalid scheme: %s", u.Scheme)
-	}
-	u.Path = path.Join(u.Path, endpoint)
-
-	values := u.Query()
-	for key, value := range args {
-		values.Add(key, value)
-	}
-	u.RawQuery = values.Encode()
 
 	var buf bytes.Buffer
 	if body != nil {
@@ -103,13 +146,14 @@ func (tzkt *API) post(ctx context.Context, endpoint string, args map[string]stri
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), &buf)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("Content-Type", "application/json")
-
-	return tzkt.client.Do(req) //nolint:gosec
+	return tzkt.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, link, bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Content-Type", "application/json")
+		return req, nil
+	})
 }
 
 func (tzkt *API) json(ctx context.Context, endpoint string, args map[string]string, withAuth bool, output interface{}) error {
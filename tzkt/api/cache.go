@@ -0,0 +1,186 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// head level is requested again for unconfirmed requests after the interval
+const cacheHeadTTL = 10 * time.Second
+
+// endpoints whose responses don't change if they are bounded by a confirmed level
+var cacheablePrefixes = []string{
+	"/v1/blocks",
+	"/v1/contracts/events",
+	"/v1/operations/",
+	"/v1/bigmaps/updates",
+}
+
+// cacheableLevel - returns the highest level which the request can return. It returns false if the request
+// is not level-bounded or its endpoint returns mutable data.
+func cacheableLevel(endpoint string, args map[string]string) (uint64, bool) {
+	var cacheable bool
+	for _, prefix := range cacheablePrefixes {
+		if strings.HasPrefix(endpoint, prefix) {
+			cacheable = true
+			break
+		}
+	}
+	if !cacheable {
+		return 0, false
+	}
+
+	// single block: /v1/blocks/{level}
+	if level, ok := strings.CutPrefix(endpoint, "/v1/blocks/"); ok {
+		value, err := strconv.ParseUint(level, 10, 64)
+		return value, err == nil
+	}
+
+	for _, key := range []string{"level", "level.eq", "level.le"} {
+		if value, ok := args[key]; ok {
+			level, err := strconv.ParseUint(value, 10, 64)
+			return level, err == nil
+		}
+	}
+	if value, ok := args["level.lt"]; ok {
+		level, err := strconv.ParseUint(value, 10, 64)
+		return level - 1, err == nil && level > 0
+	}
+	if value, ok := args["level.in"]; ok {
+		var highest uint64
+		for item := range strings.SplitSeq(value, ",") {
+			level, err := strconv.ParseUint(item, 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			highest = max(highest, level)
+		}
+		return highest, true
+	}
+	return 0, false
+}
+
+type cacheItem struct {
+	key  string
+	data []byte
+}
+
+// responseCache - LRU cache of response bodies
+type responseCache struct {
+	mx            sync.Mutex
+	size          int
+	confirmations uint64
+	items         map[string]*list.Element
+	order         *list.List
+
+	head        uint64
+	headUpdated time.Time
+}
+
+func newResponseCache(size int, confirmations uint64) *responseCache {
+	return &responseCache{
+		size:          size,
+		confirmations: confirmations,
+		items:         make(map[string]*list.Element),
+		order:         list.New(),
+	}
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cacheItem).data, true
+}
+
+func (c *responseCache) set(key string, data []byte) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		element.Value.(*cacheItem).data = data
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&cacheItem{key: key, data: data})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheItem).key)
+	}
+}
+
+func (c *responseCache) getHead() (uint64, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.head, time.Since(c.headUpdated) < cacheHeadTTL
+}
+
+func (c *responseCache) setHead(level uint64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.head = max(c.head, level)
+	c.headUpdated = time.Now()
+}
+
+// isConfirmed - returns true if `level` is at least `confirmations` levels below head. Head is requested when it's unknown or stale.
+func (tzkt *API) isConfirmed(ctx context.Context, level uint64) bool {
+	head, fresh := tzkt.cache.getHead()
+	// head only grows, so stale head is enough to confirm the level
+	if level+tzkt.cache.confirmations <= head {
+		return true
+	}
+	if fresh {
+		return false
+	}
+
+	current, err := tzkt.GetHead(ctx)
+	if err != nil {
+		return false
+	}
+	tzkt.cache.setHead(current.Level)
+	return level+tzkt.cache.confirmations <= current.Level
+}
+
+// cached - returns response from cache or requests it and stores successful response
+func (tzkt *API) cached(ctx context.Context, link string) (*http.Response, error) {
+	if data, ok := tzkt.cache.get(link); ok {
+		return newCachedResponse(data), nil
+	}
+
+	resp, err := tzkt.getURL(ctx, link, false)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	tzkt.cache.set(link, data)
+	return newCachedResponse(data), nil
+}
+
+func newCachedResponse(data []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+	}
+}
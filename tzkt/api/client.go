@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// default retry parameters
+const (
+	DefaultRetryAttempts   = 3
+	DefaultRetryMinBackoff = 200 * time.Millisecond
+	DefaultRetryMaxBackoff = 10 * time.Second
+)
+
+type retryPolicy struct {
+	attempts   int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+type apiKey struct {
+	header string
+	key    string
+}
+
+func (tzkt *API) wait(ctx context.Context) error {
+	if tzkt.limiter == nil {
+		return nil
+	}
+	return tzkt.limiter.Wait(ctx)
+}
+
+// do - sends request created by `newRequest` with rate limit and retries on connection errors, 429 and 5xx statuses
+func (tzkt *API) do(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if err := tzkt.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		if tzkt.apiKey != nil {
+			req.Header.Set(tzkt.apiKey.header, tzkt.apiKey.key)
+		}
+
+		resp, err := tzkt.client.Do(req) //nolint:gosec
+		if attempt >= tzkt.retry.attempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if !isRetryableError(err) {
+				return nil, err
+			}
+		case isRetryableStatus(resp.StatusCode):
+			delay = retryAfter(resp)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if delay == 0 {
+			delay = tzkt.retry.backoff(attempt)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	delay := p.maxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if d := p.minBackoff << shift; d > 0 && d < p.maxBackoff {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + rand.N(half+1) //nolint:gosec
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableError - every error of `http.Client.Do` implements `net.Error`, so only timeouts and connection errors are retried
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/go-lib/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_Retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		status    int
+		opts      []Option
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name:      "retry after 429",
+			failures:  2,
+			status:    http.StatusTooManyRequests,
+			opts:      []Option{WithRetry(3, time.Millisecond, time.Millisecond)},
+			wantCalls: 3,
+		}, {
+			name:      "attempts exhausted",
+			failures:  3,
+			status:    http.StatusBadGateway,
+			opts:      []Option{WithRetry(2, time.Millisecond, time.Millisecond)},
+			wantErr:   true,
+			wantCalls: 2,
+		}, {
+			name:      "client error is not retried",
+			failures:  1,
+			status:    http.StatusBadRequest,
+			opts:      []Option{WithRetry(3, time.Millisecond, time.Millisecond)},
+			wantErr:   true,
+			wantCalls: 1,
+		}, {
+			name:      "without retry",
+			failures:  1,
+			status:    http.StatusServiceUnavailable,
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"level":100}`))
+			}))
+			defer server.Close()
+
+			head, err := New(server.URL, tt.opts...).GetHead(t.Context())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.EqualValues(t, 100, head.Level)
+			}
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "url error without timeout",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: errors.New("tls: failed to verify certificate")},
+		}, {
+			name: "timeout",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: timeoutError{}},
+			want: true,
+		}, {
+			name: "connection reset",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}},
+			want: true,
+		}, {
+			name: "connection refused",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}},
+			want: true,
+		}, {
+			name: "EOF",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: io.EOF},
+			want: true,
+		}, {
+			name: "dns error",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.tzkt.io", IsNotFound: true}}},
+		}, {
+			name: "canceled",
+			err:  &url.Error{Op: "Get", URL: "https://api.tzkt.io/v1/head", Err: context.Canceled},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableError(tt.err))
+		})
+	}
+}
+
+func TestNewFromDataSource(t *testing.T) {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Api-Key")
+		_, _ = w.Write([]byte(`{"level":100}`))
+	}))
+	defer server.Close()
+
+	api := NewFromDataSource(config.DataSource{
+		URL:               server.URL,
+		Timeout:           5,
+		RequestsPerSecond: 10,
+		Credentials: &config.Credentials{
+			ApiKey: &config.ApiKey{Header: "X-Api-Key", Key: "secret"},
+		},
+	})
+	_, err := api.GetHead(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, "secret", header)
+	assert.Equal(t, 5*time.Second, api.client.Timeout)
+	assert.NotNil(t, api.limiter)
+	assert.Equal(t, DefaultRetryAttempts, api.retry.attempts)
+}
+
+func TestAPI_Cache(t *testing.T) {
+	var (
+		mx    sync.Mutex
+		calls = make(map[string]int)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mx.Lock()
+		calls[r.URL.Path]++
+		mx.Unlock()
+
+		switch {
+		case r.URL.Path == "/v1/head":
+			_, _ = w.Write([]byte(`{"level":100}`))
+		case strings.HasPrefix(r.URL.Path, "/v1/blocks/"):
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	api := New(server.URL, WithCache(10, 2))
+	for range 3 {
+		_, err := api.GetBlock(t.Context(), 98)
+		require.NoError(t, err)
+		_, err = api.GetBlock(t.Context(), 99)
+		require.NoError(t, err)
+		_, err = api.GetTransactions(t.Context(), NewQuery(FieldLevel.Le(50)))
+		require.NoError(t, err)
+		_, err = api.GetTransactions(t.Context(), NewQuery(FieldLevel.Gt(50)))
+		require.NoError(t, err)
+		_, err = api.GetDelegates(t.Context(), NewQuery(FieldLevel.Le(50)))
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, map[string]int{
+		"/v1/head":                    1,
+		"/v1/blocks/98":               1,
+		"/v1/blocks/99":               3,
+		"/v1/operations/transactions": 4,
+		"/v1/delegates":               3,
+	}, calls)
+}
+
+func TestCacheableLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		args      map[string]string
+		wantLevel uint64
+		wantOk    bool
+	}{
+		{
+			name:      "block by level",
+			endpoint:  "/v1/blocks/100",
+			wantLevel: 100,
+			wantOk:    true,
+		}, {
+			name:     "blocks count",
+			endpoint: "/v1/blocks/count",
+		}, {
+			name:      "operations at level",
+			endpoint:  "/v1/operations/transactions",
+			args:      map[string]string{"level": "10"},
+			wantLevel: 10,
+			wantOk:    true,
+		}, {
+			name:      "operations before level",
+			endpoint:  "/v1/operations/transactions",
+			args:      map[string]string{"level.lt": "10"},
+			wantLevel: 9,
+			wantOk:    true,
+		}, {
+			name:      "operations in levels",
+			endpoint:  "/v1/operations/originations",
+			args:      map[string]string{"level.in": "10,30,20"},
+			wantLevel: 30,
+			wantOk:    true,
+		}, {
+			name:     "operations after level",
+			endpoint: "/v1/operations/transactions",
+			args:     map[string]string{"level.gt": "10"},
+		}, {
+			name:     "token transfers",
+			endpoint: "/v1/tokens/transfers",
+			args:     map[string]string{"level.le": "10"},
+		}, {
+			name:     "ticket transfers",
+			endpoint: "/v1/tickets/transfers",
+			args:     map[string]string{"level": "10"},
+		}, {
+			name:      "contract events",
+			endpoint:  "/v1/contracts/events",
+			args:      map[string]string{"level.le": "10"},
+			wantLevel: 10,
+			wantOk:    true,
+		}, {
+			name:     "mutable endpoint",
+			endpoint: "/v1/bigmaps/1/keys",
+			args:     map[string]string{"level.le": "10"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, ok := cacheableLevel(tt.endpoint, tt.args)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantLevel, level)
+			}
+		})
+	}
+}
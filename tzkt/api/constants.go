@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetConstantsCount - Returns a number of global constants.
+func (tzkt *API) GetConstantsCount(ctx context.Context, filters map[string]string) (uint64, error) {
+	return tzkt.count(ctx, "/v1/constants/count", filters)
+}
+
+// GetConstants - Returns a list of global constants.
+func (tzkt *API) GetConstants(ctx context.Context, filters map[string]string) (constants []data.Constant, err error) {
+	err = tzkt.json(ctx, "/v1/constants", filters, false, &constants)
+	return
+}
+
+// GetConstant - Returns a global constant with the specified address (expression hash).
+func (tzkt *API) GetConstant(ctx context.Context, address string, filters map[string]string) (constant data.Constant, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/constants/%s", address), filters, false, &constant)
+	return
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/dipdup-io/go-lib/tools/ast"
 	"github.com/dipdup-io/go-lib/tzkt/data"
 	"github.com/pkg/errors"
 )
@@ -50,3 +51,51 @@ func (tzkt *API) ListContracts(ctx context.Context, filters map[string]string) (
 	err = tzkt.json(ctx, "/v1/contracts", filters, false, &response)
 	return
 }
+
+// GetContractEntrypoints - Returns entrypoints of the contract.
+func (tzkt *API) GetContractEntrypoints(ctx context.Context, address string, filters map[string]string) (response []data.Entrypoint, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/contracts/%s/entrypoints", address), filters, false, &response)
+	return
+}
+
+// GetContractEntrypoint - Returns the contract entrypoint with the specified name.
+func (tzkt *API) GetContractEntrypoint(ctx context.Context, address, name string, filters map[string]string) (response data.Entrypoint, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/contracts/%s/entrypoints/%s", address, name), filters, false, &response)
+	return
+}
+
+// GetContractViews - Returns on-chain views of the contract.
+func (tzkt *API) GetContractViews(ctx context.Context, address string, filters map[string]string) (response []data.ContractView, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/contracts/%s/views", address), filters, false, &response)
+	return
+}
+
+// GetContractEvents - Returns contract events emitted by `EMIT` instruction. Events can be filtered by `FieldContract`, `FieldTag` and `FieldCodeHash`.
+func (tzkt *API) GetContractEvents(ctx context.Context, filters map[string]string) (response []data.ContractEvent, err error) {
+	err = tzkt.json(ctx, "/v1/contracts/events", filters, false, &response)
+	return
+}
+
+// GetContractEventsCount - Returns a number of contract events.
+func (tzkt *API) GetContractEventsCount(ctx context.Context, filters map[string]string) (uint64, error) {
+	return tzkt.count(ctx, "/v1/contracts/events/count", filters)
+}
+
+// DecodeContractEvent - decodes event payload with the event type using `tools/ast`. If the event doesn't contain `type`
+// and `rawPayload` fields (e.g. it's received from subscription), they are requested by event id.
+func (tzkt *API) DecodeContractEvent(ctx context.Context, event data.ContractEvent) (*ast.TypedAst, error) {
+	if len(event.Type) > 0 && len(event.RawPayload) > 0 {
+		return event.Decode()
+	}
+
+	events, err := tzkt.GetContractEvents(ctx, NewQuery(FieldID.Eq(int64(event.ID))).Select(FieldType, FieldRawPayload))
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, errors.Errorf("contract event is not found: %d", event.ID)
+	}
+	event.Type = events[0].Type
+	event.RawPayload = events[0].RawPayload
+	return event.Decode()
+}
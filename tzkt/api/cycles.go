@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetCyclesCount - Returns a total number of cycles including future ones.
+func (tzkt *API) GetCyclesCount(ctx context.Context) (uint64, error) {
+	return tzkt.count(ctx, "/v1/cycles/count", nil)
+}
+
+// GetCycles - Returns a list of cycles including future ones.
+func (tzkt *API) GetCycles(ctx context.Context, filters map[string]string) (cycles []data.Cycle, err error) {
+	err = tzkt.json(ctx, "/v1/cycles", filters, false, &cycles)
+	return
+}
+
+// GetCycle - Returns a cycle with the specified index.
+func (tzkt *API) GetCycle(ctx context.Context, index uint64, filters map[string]string) (cycle data.Cycle, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/cycles/%d", index), filters, false, &cycle)
+	return
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetDomainsCount - Returns a number of Tezos domains.
+func (tzkt *API) GetDomainsCount(ctx context.Context, filters map[string]string) (uint64, error) {
+	return tzkt.count(ctx, "/v1/domains/count", filters)
+}
+
+// GetDomains - Returns a list of Tezos domains.
+func (tzkt *API) GetDomains(ctx context.Context, filters map[string]string) (domains []data.Domain, err error) {
+	err = tzkt.json(ctx, "/v1/domains", filters, false, &domains)
+	return
+}
+
+// GetDomain - Returns a domain with the specified name.
+func (tzkt *API) GetDomain(ctx context.Context, name string) (domain data.Domain, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/domains/%s", name), nil, false, &domain)
+	return
+}
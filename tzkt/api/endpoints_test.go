@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_Endpoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		call     func(ctx context.Context, api *API) (any, error)
+		wantURL  string
+		want     any
+	}{
+		{
+			name:     "account balance history",
+			response: `[{"level":10,"timestamp":"2024-01-01T00:00:00Z","balance":100}]`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetAccountBalanceHistory(ctx, "tz1", NewQuery().Set("step", "10"))
+			},
+			wantURL: "/v1/accounts/tz1/balance_history?step=10",
+		}, {
+			name:     "account balance at level",
+			response: `12345`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetAccountBalanceAtLevel(ctx, "tz1", 10)
+			},
+			wantURL: "/v1/accounts/tz1/balance_history/10",
+			want:    uint64(12345),
+		}, {
+			name:     "contract views",
+			response: `[{"name":"get_balance","michelsonReturnType":"nat"}]`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetContractViews(ctx, "KT1", nil)
+			},
+			wantURL: "/v1/contracts/KT1/views",
+			want:    []data.ContractView{{Name: "get_balance", MichelsonReturnType: "nat"}},
+		}, {
+			name:     "cycle",
+			response: `{"index":700,"firstLevel":1}`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetCycle(ctx, 700, nil)
+			},
+			wantURL: "/v1/cycles/700",
+			want:    data.Cycle{Index: 700, FirstLevel: 1},
+		}, {
+			name:     "current voting period",
+			response: `{"index":120,"epoch":60,"kind":"proposal","status":"active"}`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetCurrentVotingPeriod(ctx)
+			},
+			wantURL: "/v1/voting/periods/current",
+			want:    data.VotingPeriod{Index: 120, Epoch: 60, Kind: "proposal", Status: "active"},
+		}, {
+			name:     "baker rewards by cycle",
+			response: `{"cycle":700,"blocks":3,"nonceRevelationLosses":-10}`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetBakerRewardsByCycle(ctx, "tz1", 700)
+			},
+			wantURL: "/v1/rewards/bakers/tz1/700",
+			want:    data.BakerRewards{Cycle: 700, Blocks: 3, NonceRevelationLosses: -10},
+		}, {
+			name:     "staking updates",
+			response: `[{"id":1,"type":"stake","amount":1000,"staker":{"address":"tz1"}}]`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetStakingUpdates(ctx, NewQuery(FieldType.Eq(data.StakingUpdateStake)))
+			},
+			wantURL: "/v1/staking/updates?type.eq=stake",
+			want: []data.StakingUpdate{
+				{ID: 1, Type: data.StakingUpdateStake, Amount: 1000, Staker: &data.Address{Address: "tz1"}},
+			},
+		}, {
+			name:     "smart rollup commitments",
+			response: `[{"id":1,"hash":"src1","predecessor":{"id":0,"hash":"src0"}}]`,
+			call: func(ctx context.Context, api *API) (any, error) {
+				return api.GetSmartRollupCommitments(ctx, "sr1", NewQuery().Limit(1))
+			},
+			wantURL: "/v1/smart_rollups/commitments?limit=1&rollup=sr1",
+			want: []data.SmartRollupCommitment{
+				{ID: 1, Hash: "src1", Predecessor: &data.SmartRollupCommitmentShort{Hash: "src0"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL = r.URL.String()
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			got, err := tt.call(t.Context(), New(server.URL))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURL, gotURL)
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAPI_DecodeContractEvent(t *testing.T) {
+	const (
+		eventType    = `{"prim":"pair","args":[{"prim":"address","annots":["%sender"]},{"prim":"nat","annots":["%amount"]}]}`
+		eventPayload = `{"prim":"Pair","args":[{"string":"tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb"},{"int":"100"}]}`
+	)
+
+	tests := []struct {
+		name         string
+		event        data.ContractEvent
+		wantRequests int
+	}{
+		{
+			name: "event with type",
+			event: data.ContractEvent{
+				ID:         1,
+				Tag:        "deposit",
+				Type:       []byte(eventType),
+				RawPayload: []byte(eventPayload),
+			},
+		}, {
+			name: "type is requested",
+			event: data.ContractEvent{
+				ID:      1,
+				Tag:     "deposit",
+				Payload: []byte(`{"sender":"tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb","amount":"100"}`),
+			},
+			wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, "/v1/contracts/events?id.eq=1&select=type%2CrawPayload", r.URL.String())
+				_, _ = w.Write([]byte(`[{"type":` + eventType + `,"rawPayload":` + eventPayload + `}]`))
+			}))
+			defer server.Close()
+
+			tree, err := New(server.URL).DecodeContractEvent(t.Context(), tt.event)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRequests, requests)
+
+			nodes, err := tree.ToMiguel()
+			require.NoError(t, err)
+			require.Len(t, nodes, 1)
+			require.Len(t, nodes[0].Children, 2)
+			require.NotNil(t, nodes[0].Children[0].Name)
+			assert.Equal(t, "sender", *nodes[0].Children[0].Name)
+			assert.Equal(t, "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb", nodes[0].Children[0].Value)
+			require.NotNil(t, nodes[0].Children[1].Name)
+			assert.Equal(t, "amount", *nodes[0].Children[1].Name)
+			assert.Equal(t, "100", nodes[0].Children[1].Value)
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package api
 
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
 // Option -
 type Option func(*API)
 
@@ -10,3 +17,80 @@ func WithAuth(user, privateKey string) Option {
 		api.privateKey = privateKey
 	}
 }
+
+// WithTransport - sets transport of TzKT API client. Nil transport keeps the default one.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(api *API) {
+		if transport != nil {
+			api.client.Transport = transport
+		}
+	}
+}
+
+// WithTimeout - sets timeout of a single HTTP request. Default: 1 minute.
+func WithTimeout(timeout time.Duration) Option {
+	return func(api *API) {
+		if timeout > 0 {
+			api.client.Timeout = timeout
+		}
+	}
+}
+
+// WithRateLimit - limits requests per second with token bucket. The limiter is created once per option,
+// so clients built with the same option share the limit.
+func WithRateLimit(rps int) Option {
+	if rps <= 0 {
+		return func(api *API) {}
+	}
+	limiter := rate.NewLimiter(rate.Limit(rps), rps)
+	return func(api *API) {
+		api.limiter = limiter
+	}
+}
+
+// WithRetry - retries requests failed with connection errors, 429 and 5xx statuses.
+// Delay between attempts grows exponentially from `minBackoff` to `maxBackoff` with random jitter.
+// `Retry-After` header of response has priority over backoff.
+func WithRetry(attempts int, minBackoff, maxBackoff time.Duration) Option {
+	return func(api *API) {
+		if attempts < 1 {
+			attempts = 1
+		}
+		if minBackoff <= 0 {
+			minBackoff = DefaultRetryMinBackoff
+		}
+		if maxBackoff < minBackoff {
+			maxBackoff = minBackoff
+		}
+		api.retry = retryPolicy{
+			attempts:   attempts,
+			minBackoff: minBackoff,
+			maxBackoff: maxBackoff,
+		}
+	}
+}
+
+// WithDefaultRetry - retries requests with default parameters
+func WithDefaultRetry() Option {
+	return WithRetry(DefaultRetryAttempts, DefaultRetryMinBackoff, DefaultRetryMaxBackoff)
+}
+
+// WithAPIKey - sends `key` in `header` with every request
+func WithAPIKey(header, key string) Option {
+	return func(api *API) {
+		if header != "" {
+			api.apiKey = &apiKey{header: header, key: key}
+		}
+	}
+}
+
+// WithCache - caches up to `size` responses which can't change anymore: blocks and history requests
+// (operations, contract events and big map updates) bounded by level which is at least `confirmations` levels below head.
+// Token and ticket transfers are not cached because they embed mutable token metadata and supply.
+func WithCache(size int, confirmations uint64) Option {
+	return func(api *API) {
+		if size > 0 {
+			api.cache = newResponseCache(size, confirmations)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+	"github.com/pkg/errors"
+)
+
+// default pagination parameters
+const (
+	DefaultPageSize = 1000
+	MaxPageSize     = 10000
+)
+
+// PageOption -
+type PageOption func(*pageParams)
+
+type pageParams struct {
+	size     uint64
+	prefetch bool
+}
+
+// WithPageSize - sets count of entities requested at once. Default: 1000, maximum: 10000.
+func WithPageSize(size uint64) PageOption {
+	return func(p *pageParams) {
+		if size > 0 {
+			p.size = min(size, MaxPageSize)
+		}
+	}
+}
+
+// WithPrefetch - requests the next page while the current one is iterated
+func WithPrefetch() PageOption {
+	return func(p *pageParams) {
+		p.prefetch = true
+	}
+}
+
+// PageFetcher - list getter of API, e.g. `tzkt.GetTransactions`
+type PageFetcher[T any] func(ctx context.Context, filters map[string]string) ([]T, error)
+
+type page[T any] struct {
+	items []T
+	err   error
+}
+
+// Paginate - iterates over all entities matching `filters` with cursor pagination by `id`. Only one page
+// (two with prefetch) is kept in memory. `id` returns identifier of entity used as cursor.
+// Entities are sorted by `id` in ascending order unless `sort.desc=id` is set. Sorting by other fields is not supported.
+// Iteration stops after the first error.
+func Paginate[T any](ctx context.Context, filters map[string]string, fetch PageFetcher[T], id func(T) uint64, opts ...PageOption) iter.Seq2[T, error] {
+	params := pageParams{
+		size: DefaultPageSize,
+	}
+	for i := range opts {
+		opts[i](&params)
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		query, err := newPagingQuery(filters, params.size)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		fetchPage := func(q Query) page[T] {
+			items, err := fetch(ctx, q)
+			return page[T]{items, err}
+		}
+
+		current := fetchPage(query)
+		for {
+			if current.err != nil {
+				yield(zero, current.err)
+				return
+			}
+
+			last := uint64(len(current.items)) < params.size
+			var next <-chan page[T]
+			if !last {
+				query = query.Clone().OffsetCursor(id(current.items[len(current.items)-1]))
+				if params.prefetch {
+					ch := make(chan page[T], 1)
+					go func(q Query) {
+						ch <- fetchPage(q)
+					}(query)
+					next = ch
+				}
+			}
+
+			for i := range current.items {
+				if !yield(current.items[i], nil) {
+					return
+				}
+			}
+
+			if last {
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if next != nil {
+				current = <-next
+			} else {
+				current = fetchPage(query)
+			}
+		}
+	}
+}
+
+func newPagingQuery(filters map[string]string, size uint64) (Query, error) {
+	query := make(Query, len(filters)+3).Merge(filters)
+
+	if field, ok := query["sort.asc"]; ok && field != string(FieldID) {
+		return nil, errors.Errorf("cursor pagination requires sorting by id: sort.asc=%s", field)
+	}
+	if field, ok := query["sort.desc"]; ok && field != string(FieldID) {
+		return nil, errors.Errorf("cursor pagination requires sorting by id: sort.desc=%s", field)
+	}
+	if _, ok := query["sort.desc"]; !ok {
+		query.SortAsc(FieldID)
+	}
+	delete(query, "sort")
+	delete(query, "offset")
+	delete(query, "offset.pg")
+
+	if _, ok := query["select.values"]; ok {
+		return nil, errors.New("cursor pagination doesn't support select.values, use select")
+	}
+	if selected, ok := query["select"]; ok && !slices.Contains(strings.Split(selected, ","), string(FieldID)) {
+		query["select"] = fmt.Sprintf("%s,%s", selected, FieldID)
+	}
+
+	return query.Limit(size), nil
+}
+
+// IterateTransactions - iterates over all transactions matching `filters`
+func (tzkt *API) IterateTransactions(ctx context.Context, filters map[string]string, opts ...PageOption) iter.Seq2[data.Transaction, error] {
+	return Paginate(ctx, filters, tzkt.GetTransactions, func(tx data.Transaction) uint64 { return tx.ID }, opts...)
+}
+
+// IterateBigmapKeys - iterates over all keys of big map `id` matching `filters`
+func (tzkt *API) IterateBigmapKeys(ctx context.Context, id uint64, filters map[string]string, opts ...PageOption) iter.Seq2[data.BigMapKey, error] {
+	fetch := func(ctx context.Context, filters map[string]string) ([]data.BigMapKey, error) {
+		return tzkt.GetBigmapKeys(ctx, id, filters)
+	}
+	return Paginate(ctx, filters, fetch, func(key data.BigMapKey) uint64 { return key.ID }, opts...)
+}
+
+// IterateBigmapUpdates - iterates over all big map updates matching `filters`
+func (tzkt *API) IterateBigmapUpdates(ctx context.Context, filters map[string]string, opts ...PageOption) iter.Seq2[data.BigMapUpdate, error] {
+	return Paginate(ctx, filters, tzkt.GetBigmapUpdates, func(update data.BigMapUpdate) uint64 { return update.ID }, opts...)
+}
+
+// IterateTokenTransfers - iterates over all token transfers matching `filters`
+func (tzkt *API) IterateTokenTransfers(ctx context.Context, filters map[string]string, opts ...PageOption) iter.Seq2[data.Transfer, error] {
+	return Paginate(ctx, filters, tzkt.GetTokenTransfers, func(transfer data.Transfer) uint64 { return transfer.ID }, opts...)
+}
+
+// IterateTicketTransfers - iterates over all ticket transfers matching `filters`
+func (tzkt *API) IterateTicketTransfers(ctx context.Context, filters map[string]string, opts ...PageOption) iter.Seq2[data.TicketTransfer, error] {
+	return Paginate(ctx, filters, tzkt.GetTicketTransfers, func(transfer data.TicketTransfer) uint64 { return uint64(transfer.ID) }, opts...)
+}
+
+// IterateContractEvents - iterates over all contract events matching `filters`
+func (tzkt *API) IterateContractEvents(ctx context.Context, filters map[string]string, opts ...PageOption) iter.Seq2[data.ContractEvent, error] {
+	return Paginate(ctx, filters, tzkt.GetContractEvents, func(event data.ContractEvent) uint64 { return event.ID }, opts...)
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagingServer - serves transactions with identifiers from 1 to `count` with cursor pagination
+func newPagingServer(t *testing.T, count uint64, requests *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		query := r.URL.Query()
+		limit, err := strconv.ParseUint(query.Get("limit"), 10, 64)
+		require.NoError(t, err)
+
+		var cursor uint64
+		if value := query.Get("offset.cr"); value != "" {
+			cursor, err = strconv.ParseUint(value, 10, 64)
+			require.NoError(t, err)
+		}
+
+		items := make([]string, 0, limit)
+		if query.Get("sort.desc") == "id" {
+			if cursor == 0 {
+				cursor = count + 1
+			}
+			for id := cursor - 1; id > 0 && uint64(len(items)) < limit; id-- {
+				items = append(items, fmt.Sprintf(`{"id":%d}`, id))
+			}
+		} else {
+			for id := cursor + 1; id <= count && uint64(len(items)) < limit; id++ {
+				items = append(items, fmt.Sprintf(`{"id":%d}`, id))
+			}
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+}
+
+func TestAPI_IterateTransactions(t *testing.T) {
+	tests := []struct {
+		name         string
+		count        uint64
+		filters      map[string]string
+		opts         []PageOption
+		wantFirst    uint64
+		wantLast     uint64
+		wantCount    int
+		wantRequests int64
+	}{
+		{
+			name:         "several pages",
+			count:        25,
+			opts:         []PageOption{WithPageSize(10)},
+			wantFirst:    1,
+			wantLast:     25,
+			wantCount:    25,
+			wantRequests: 3,
+		}, {
+			name:         "exact pages with prefetch",
+			count:        20,
+			opts:         []PageOption{WithPageSize(10), WithPrefetch()},
+			wantFirst:    1,
+			wantLast:     20,
+			wantCount:    20,
+			wantRequests: 3,
+		}, {
+			name:         "descending from cursor",
+			count:        25,
+			filters:      NewQuery().SortDesc(FieldID).OffsetCursor(21),
+			opts:         []PageOption{WithPageSize(7)},
+			wantFirst:    20,
+			wantLast:     1,
+			wantCount:    20,
+			wantRequests: 3,
+		}, {
+			name:         "empty",
+			count:        0,
+			wantCount:    0,
+			wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int64
+			server := newPagingServer(t, tt.count, &requests)
+			defer server.Close()
+
+			var txs []data.Transaction
+			for tx, err := range New(server.URL).IterateTransactions(t.Context(), tt.filters, tt.opts...) {
+				require.NoError(t, err)
+				txs = append(txs, tx)
+			}
+
+			require.Len(t, txs, tt.wantCount)
+			if tt.wantCount > 0 {
+				assert.Equal(t, tt.wantFirst, txs[0].ID)
+				assert.Equal(t, tt.wantLast, txs[len(txs)-1].ID)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestAPI_IterateTransactions_Break(t *testing.T) {
+	var requests atomic.Int64
+	server := newPagingServer(t, 100, &requests)
+	defer server.Close()
+
+	var count int
+	for _, err := range New(server.URL).IterateTransactions(t.Context(), nil, WithPageSize(10)) {
+		require.NoError(t, err)
+		count++
+		if count == 15 {
+			break
+		}
+	}
+	assert.Equal(t, 15, count)
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestAPI_IterateTransactions_Errors(t *testing.T) {
+	var requests atomic.Int64
+	server := newPagingServer(t, 100, &requests)
+	defer server.Close()
+
+	api := New(server.URL)
+
+	t.Run("unsupported sorting", func(t *testing.T) {
+		for _, err := range api.IterateTransactions(t.Context(), NewQuery().SortAsc(FieldLevel)) {
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		var (
+			count   int
+			lastErr error
+		)
+		for _, err := range api.IterateTransactions(ctx, nil, WithPageSize(10)) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			count++
+			if count == 5 {
+				cancel()
+			}
+		}
+		assert.Equal(t, 10, count)
+		require.ErrorIs(t, lastErr, context.Canceled)
+	})
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query - typed builder of TzKT query parameters. Its underlying type is `map[string]string`,
+// so it's accepted by every getter as filters and can be mixed with legacy maps via `Merge`.
+//
+//	query := api.NewQuery(
+//		api.FieldTarget.Eq("KT1..."),
+//		api.FieldLevel.Gt(1000000),
+//	).Select(api.FieldID, api.FieldLevel).SortAsc(api.FieldID).Limit(100)
+//	transactions, err := tzkt.GetTransactions(ctx, query)
+type Query map[string]string
+
+// NewQuery - creates query with conditions
+func NewQuery(conditions ...Condition) Query {
+	return make(Query).Where(conditions...)
+}
+
+func (q Query) init() Query {
+	if q == nil {
+		return make(Query)
+	}
+	return q
+}
+
+// Where - adds conditions. Condition replaces the previous one with the same field and operator.
+func (q Query) Where(conditions ...Condition) Query {
+	q = q.init()
+	for _, c := range conditions {
+		q[c.Key] = c.Value
+	}
+	return q
+}
+
+// Select - requests only `fields` of entities. Response contains objects with the fields.
+func (q Query) Select(fields ...Field) Query {
+	return q.Set("select", joinFields(fields))
+}
+
+// SelectValues - requests only `fields` of entities. Response contains arrays of values in fields order
+// or single values if one field is selected.
+func (q Query) SelectValues(fields ...Field) Query {
+	return q.Set("select.values", joinFields(fields))
+}
+
+// SortAsc - sorts entities by `field` in ascending order
+func (q Query) SortAsc(field Field) Query {
+	q = q.init()
+	delete(q, "sort.desc")
+	q["sort.asc"] = field.FieldName()
+	return q
+}
+
+// SortDesc - sorts entities by `field` in descending order
+func (q Query) SortDesc(field Field) Query {
+	q = q.init()
+	delete(q, "sort.asc")
+	q["sort.desc"] = field.FieldName()
+	return q
+}
+
+// Limit - sets maximum count of returned entities
+func (q Query) Limit(limit uint64) Query {
+	return q.Set("limit", strconv.FormatUint(limit, 10))
+}
+
+// Offset - skips `offset` entities
+func (q Query) Offset(offset uint64) Query {
+	return q.Set("offset", strconv.FormatUint(offset, 10))
+}
+
+// OffsetCursor - returns entities after the entity with `id` according to sorting. It's the fastest way of paging.
+func (q Query) OffsetCursor(id uint64) Query {
+	return q.Set("offset.cr", strconv.FormatUint(id, 10))
+}
+
+// Set - sets raw query parameter
+func (q Query) Set(key, value string) Query {
+	q = q.init()
+	q[key] = value
+	return q
+}
+
+// Merge - adds legacy filters to the query. Values of `filters` replace existing parameters.
+func (q Query) Merge(filters map[string]string) Query {
+	q = q.init()
+	for key, value := range filters {
+		q[key] = value
+	}
+	return q
+}
+
+// Clone - returns copy of the query
+func (q Query) Clone() Query {
+	return make(Query, len(q)).Merge(q)
+}
+
+// Condition - single filter of query
+type Condition struct {
+	Key   string
+	Value string
+}
+
+func newCondition(field, operator, value string) Condition {
+	return Condition{
+		Key:   field + "." + operator,
+		Value: value,
+	}
+}
+
+// Field - field of TzKT entity which can be used in `Select` and sorting
+type Field interface {
+	FieldName() string
+}
+
+func joinFields(fields []Field) string {
+	names := make([]string, len(fields))
+	for i := range fields {
+		names[i] = fields[i].FieldName()
+	}
+	return strings.Join(names, ",")
+}
+
+func formatBool(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+// StringField - field with string values like addresses and hashes
+type StringField string
+
+// FieldName -
+func (f StringField) FieldName() string { return string(f) }
+
+// Eq - field is equal to `value`
+func (f StringField) Eq(value string) Condition {
+	return newCondition(string(f), "eq", value)
+}
+
+// Ne - field is not equal to `value`
+func (f StringField) Ne(value string) Condition {
+	return newCondition(string(f), "ne", value)
+}
+
+// In - field is equal to one of `values`
+func (f StringField) In(values ...string) Condition {
+	return newCondition(string(f), "in", strings.Join(values, ","))
+}
+
+// Ni - field is not equal to any of `values`
+func (f StringField) Ni(values ...string) Condition {
+	return newCondition(string(f), "ni", strings.Join(values, ","))
+}
+
+// As - field matches `pattern`. `*` matches any substring, e.g. `tz1*`.
+func (f StringField) As(pattern string) Condition {
+	return newCondition(string(f), "as", pattern)
+}
+
+// Un - field doesn't match `pattern`
+func (f StringField) Un(pattern string) Condition {
+	return newCondition(string(f), "un", pattern)
+}
+
+// Null - field is null if `value` is true and isn't null otherwise
+func (f StringField) Null(value bool) Condition {
+	return newCondition(string(f), "null", formatBool(value))
+}
+
+// NumberField - field with integer values like levels and identifiers
+type NumberField string
+
+// FieldName -
+func (f NumberField) FieldName() string { return string(f) }
+
+// Eq - field is equal to `value`
+func (f NumberField) Eq(value int64) Condition {
+	return newCondition(string(f), "eq", strconv.FormatInt(value, 10))
+}
+
+// Ne - field is not equal to `value`
+func (f NumberField) Ne(value int64) Condition {
+	return newCondition(string(f), "ne", strconv.FormatInt(value, 10))
+}
+
+// Gt - field is greater than `value`
+func (f NumberField) Gt(value int64) Condition {
+	return newCondition(string(f), "gt", strconv.FormatInt(value, 10))
+}
+
+// Ge - field is greater than or equal to `value`
+func (f NumberField) Ge(value int64) Condition {
+	return newCondition(string(f), "ge", strconv.FormatInt(value, 10))
+}
+
+// Lt - field is less than `value`
+func (f NumberField) Lt(value int64) Condition {
+	return newCondition(string(f), "lt", strconv.FormatInt(value, 10))
+}
+
+// Le - field is less than or equal to `value`
+func (f NumberField) Le(value int64) Condition {
+	return newCondition(string(f), "le", strconv.FormatInt(value, 10))
+}
+
+// In - field is equal to one of `values`
+func (f NumberField) In(values ...int64) Condition {
+	return newCondition(string(f), "in", joinInts(values))
+}
+
+// Ni - field is not equal to any of `values`
+func (f NumberField) Ni(values ...int64) Condition {
+	return newCondition(string(f), "ni", joinInts(values))
+}
+
+// Null - field is null if `value` is true and isn't null otherwise
+func (f NumberField) Null(value bool) Condition {
+	return newCondition(string(f), "null", formatBool(value))
+}
+
+func joinInts(values []int64) string {
+	items := make([]string, len(values))
+	for i := range values {
+		items[i] = strconv.FormatInt(values[i], 10)
+	}
+	return strings.Join(items, ",")
+}
+
+// TimeField - field with timestamp values
+type TimeField string
+
+// FieldName -
+func (f TimeField) FieldName() string { return string(f) }
+
+func formatTime(value time.Time) string {
+	return value.UTC().Format(time.RFC3339)
+}
+
+// Eq - field is equal to `value`
+func (f TimeField) Eq(value time.Time) Condition {
+	return newCondition(string(f), "eq", formatTime(value))
+}
+
+// Ne - field is not equal to `value`
+func (f TimeField) Ne(value time.Time) Condition {
+	return newCondition(string(f), "ne", formatTime(value))
+}
+
+// Gt - field is after `value`
+func (f TimeField) Gt(value time.Time) Condition {
+	return newCondition(string(f), "gt", formatTime(value))
+}
+
+// Ge - field is after or equal to `value`
+func (f TimeField) Ge(value time.Time) Condition {
+	return newCondition(string(f), "ge", formatTime(value))
+}
+
+// Lt - field is before `value`
+func (f TimeField) Lt(value time.Time) Condition {
+	return newCondition(string(f), "lt", formatTime(value))
+}
+
+// Le - field is before or equal to `value`
+func (f TimeField) Le(value time.Time) Condition {
+	return newCondition(string(f), "le", formatTime(value))
+}
+
+// BoolField - field with boolean values
+type BoolField string
+
+// FieldName -
+func (f BoolField) FieldName() string { return string(f) }
+
+// Eq - field is equal to `value`
+func (f BoolField) Eq(value bool) Condition {
+	return newCondition(string(f), "eq", formatBool(value))
+}
+
+// Null - field is null if `value` is true and isn't null otherwise
+func (f BoolField) Null(value bool) Condition {
+	return newCondition(string(f), "null", formatBool(value))
+}
+
+// JSONField - field with Micheline values decoded to JSON like parameters, storage and big map keys
+type JSONField string
+
+// FieldName -
+func (f JSONField) FieldName() string { return string(f) }
+
+// Path - returns nested field of JSON value, e.g. `FieldParameter.Path("to")` is `parameter.to`.
+// Array items are addressed by index: `FieldParameter.Path("txs", "0", "to_")`.
+func (f JSONField) Path(path ...string) StringField {
+	return StringField(strings.Join(append([]string{string(f)}, path...), "."))
+}
+
+// Eq - field is equal to JSON `value`
+func (f JSONField) Eq(value string) Condition {
+	return newCondition(string(f), "eq", value)
+}
+
+// Null - field is null if `value` is true and isn't null otherwise
+func (f JSONField) Null(value bool) Condition {
+	return newCondition(string(f), "null", formatBool(value))
+}
+
+// common fields of TzKT entities
+const (
+	FieldID            NumberField = "id"
+	FieldLevel         NumberField = "level"
+	FieldCycle         NumberField = "cycle"
+	FieldCounter       NumberField = "counter"
+	FieldAmount        NumberField = "amount"
+	FieldBigMap        NumberField = "bigmap"
+	FieldFirstLevel    NumberField = "firstLevel"
+	FieldLastLevel     NumberField = "lastLevel"
+	FieldTransactionID NumberField = "transactionId"
+	FieldOriginationID NumberField = "originationId"
+	FieldCodeHash      NumberField = "codeHash"
+	FieldTypeHash      NumberField = "typeHash"
+
+	FieldTimestamp TimeField = "timestamp"
+
+	FieldHash          StringField = "hash"
+	FieldAddress       StringField = "address"
+	FieldKind          StringField = "kind"
+	FieldType          StringField = "type"
+	FieldStatus        StringField = "status"
+	FieldSender        StringField = "sender"
+	FieldTarget        StringField = "target"
+	FieldInitiator     StringField = "initiator"
+	FieldEntrypoint    StringField = "entrypoint"
+	FieldContract      StringField = "contract"
+	FieldPath          StringField = "path"
+	FieldAction        StringField = "action"
+	FieldAccount       StringField = "account"
+	FieldFrom          StringField = "from"
+	FieldTo            StringField = "to"
+	FieldTokenContract StringField = "token.contract"
+	FieldTokenID       StringField = "token.tokenId"
+	FieldTokenStandard StringField = "token.standard"
+	FieldTag           StringField = "tag"
+
+	FieldActive       BoolField = "active"
+	FieldHasInternals BoolField = "hasInternals"
+
+	FieldParameter  JSONField = "parameter"
+	FieldStorage    JSONField = "storage"
+	FieldKey        JSONField = "key"
+	FieldValue      JSONField = "value"
+	FieldPayload    JSONField = "payload"
+	FieldRawPayload JSONField = "rawPayload"
+)
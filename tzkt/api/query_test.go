@@ -0,0 +1,102 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		want  map[string]string
+	}{
+		{
+			name:  "empty",
+			query: NewQuery(),
+			want:  map[string]string{},
+		}, {
+			name: "conditions",
+			query: NewQuery(
+				FieldTarget.Eq("KT1"),
+				FieldLevel.Gt(100),
+				FieldStatus.Ne("applied"),
+				FieldSender.In("tz1", "tz2"),
+				FieldID.Ni(1, 2),
+				FieldEntrypoint.As("mint*"),
+				FieldInitiator.Null(true),
+				FieldActive.Eq(false),
+				FieldTimestamp.Ge(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			),
+			want: map[string]string{
+				"target.eq":      "KT1",
+				"level.gt":       "100",
+				"status.ne":      "applied",
+				"sender.in":      "tz1,tz2",
+				"id.ni":          "1,2",
+				"entrypoint.as":  "mint*",
+				"initiator.null": "true",
+				"active.eq":      "false",
+				"timestamp.ge":   "2024-01-02T03:04:05Z",
+			},
+		}, {
+			name: "json path",
+			query: NewQuery(
+				FieldParameter.Path("to").Eq("tz1"),
+				FieldKey.Path("address").In("tz1", "tz2"),
+			),
+			want: map[string]string{
+				"parameter.to.eq": "tz1",
+				"key.address.in":  "tz1,tz2",
+			},
+		}, {
+			name: "paging",
+			query: NewQuery(FieldLevel.Le(10)).
+				Select(FieldID, FieldLevel, FieldParameter.Path("to")).
+				SortDesc(FieldID).
+				SortAsc(FieldLevel).
+				Limit(100).
+				OffsetCursor(50),
+			want: map[string]string{
+				"level.le":  "10",
+				"select":    "id,level,parameter.to",
+				"sort.asc":  "level",
+				"limit":     "100",
+				"offset.cr": "50",
+			},
+		}, {
+			name: "select values and offset",
+			query: Query(nil).
+				SelectValues(FieldHash).
+				Offset(20),
+			want: map[string]string{
+				"select.values": "hash",
+				"offset":        "20",
+			},
+		}, {
+			name: "merge legacy filters",
+			query: NewQuery(FieldLevel.Gt(1), FieldLevel.Gt(2)).
+				Merge(map[string]string{"sender": "tz1", "level.gt": "3"}),
+			want: map[string]string{
+				"level.gt": "3",
+				"sender":   "tz1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filters map[string]string = tt.query
+			assert.Equal(t, tt.want, filters)
+		})
+	}
+}
+
+func TestQuery_Clone(t *testing.T) {
+	query := NewQuery(FieldLevel.Gt(1))
+	clone := query.Clone().Limit(10)
+
+	assert.Equal(t, Query{"level.gt": "1"}, query)
+	assert.Equal(t, Query{"level.gt": "1", "limit": "10"}, clone)
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetQuotesCount - Returns a total number of quotes.
+func (tzkt *API) GetQuotesCount(ctx context.Context) (uint64, error) {
+	return tzkt.count(ctx, "/v1/quotes/count", nil)
+}
+
+// GetQuotes - Returns a list of historical quotes aligned with blocks.
+func (tzkt *API) GetQuotes(ctx context.Context, filters map[string]string) (quotes []data.HistoricalQuote, err error) {
+	err = tzkt.json(ctx, "/v1/quotes", filters, false, &quotes)
+	return
+}
+
+// GetLastQuote - Returns the last known quote.
+func (tzkt *API) GetLastQuote(ctx context.Context) (quote data.HistoricalQuote, err error) {
+	err = tzkt.json(ctx, "/v1/quotes/last", nil, false, &quote)
+	return
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetBakerRewardsCount - Returns a number of cycles for which the baker has rewards.
+func (tzkt *API) GetBakerRewardsCount(ctx context.Context, baker string) (uint64, error) {
+	return tzkt.count(ctx, fmt.Sprintf("/v1/rewards/bakers/%s/count", baker), nil)
+}
+
+// GetBakerRewards - Returns cycle rewards of the baker.
+func (tzkt *API) GetBakerRewards(ctx context.Context, baker string, filters map[string]string) (rewards []data.BakerRewards, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/rewards/bakers/%s", baker), filters, false, &rewards)
+	return
+}
+
+// GetBakerRewardsByCycle - Returns rewards of the baker for the specified cycle.
+func (tzkt *API) GetBakerRewardsByCycle(ctx context.Context, baker string, cycle uint64) (rewards data.BakerRewards, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/rewards/bakers/%s/%d", baker, cycle), nil, false, &rewards)
+	return
+}
+
+// GetDelegatorRewardsCount - Returns a number of cycles for which the delegator has rewards.
+func (tzkt *API) GetDelegatorRewardsCount(ctx context.Context, delegator string) (uint64, error) {
+	return tzkt.count(ctx, fmt.Sprintf("/v1/rewards/delegators/%s/count", delegator), nil)
+}
+
+// GetDelegatorRewards - Returns cycle rewards of the delegator.
+func (tzkt *API) GetDelegatorRewards(ctx context.Context, delegator string, filters map[string]string) (rewards []data.DelegatorRewards, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/rewards/delegators/%s", delegator), filters, false, &rewards)
+	return
+}
+
+// GetDelegatorRewardsByCycle - Returns rewards of the delegator for the specified cycle.
+func (tzkt *API) GetDelegatorRewardsByCycle(ctx context.Context, delegator string, cycle uint64) (rewards data.DelegatorRewards, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/rewards/delegators/%s/%d", delegator, cycle), nil, false, &rewards)
+	return
+}
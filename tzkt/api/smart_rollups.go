@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/dipdup-io/go-lib/tzkt/data"
 )
@@ -16,3 +17,15 @@ func (tzkt *API) GetSmartRollups(ctx context.Context, filters map[string]string)
 	err = tzkt.json(ctx, "/v1/smart_rollups", filters, false, &response)
 	return
 }
+
+// GetSmartRollup - Returns a smart rollup with the specified address.
+func (tzkt *API) GetSmartRollup(ctx context.Context, address string) (response data.SmartRollup, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/smart_rollups/%s", address), nil, false, &response)
+	return
+}
+
+// GetSmartRollupCommitments - Returns commitments of the smart rollup with the specified address.
+func (tzkt *API) GetSmartRollupCommitments(ctx context.Context, address string, filters map[string]string) (response []data.SmartRollupCommitment, err error) {
+	err = tzkt.json(ctx, "/v1/smart_rollups/commitments", Query(filters).Clone().Set("rollup", address), false, &response)
+	return
+}
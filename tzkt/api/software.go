@@ -0,0 +1,18 @@
+package api
+
+import (
+	"context"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetSoftwareCount - Returns a number of baker software versions.
+func (tzkt *API) GetSoftwareCount(ctx context.Context) (uint64, error) {
+	return tzkt.count(ctx, "/v1/software/count", nil)
+}
+
+// GetSoftware - Returns a list of baker software versions identified by commit hash.
+func (tzkt *API) GetSoftware(ctx context.Context, filters map[string]string) (software []data.SoftwareVersion, err error) {
+	err = tzkt.json(ctx, "/v1/software", filters, false, &software)
+	return
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"context"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetStakingUpdatesCount - Returns a number of staking updates.
+func (tzkt *API) GetStakingUpdatesCount(ctx context.Context, filters map[string]string) (uint64, error) {
+	return tzkt.count(ctx, "/v1/staking/updates/count", filters)
+}
+
+// GetStakingUpdates - Returns a list of staking updates: stakes, unstakes, finalizations and slashings.
+func (tzkt *API) GetStakingUpdates(ctx context.Context, filters map[string]string) (updates []data.StakingUpdate, err error) {
+	err = tzkt.json(ctx, "/v1/staking/updates", filters, false, &updates)
+	return
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/go-lib/tzkt/data"
+)
+
+// GetVotingPeriods - Returns a list of voting periods.
+func (tzkt *API) GetVotingPeriods(ctx context.Context, filters map[string]string) (periods []data.VotingPeriod, err error) {
+	err = tzkt.json(ctx, "/v1/voting/periods", filters, false, &periods)
+	return
+}
+
+// GetVotingPeriod - Returns a voting period with the specified index.
+func (tzkt *API) GetVotingPeriod(ctx context.Context, index uint64) (period data.VotingPeriod, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/voting/periods/%d", index), nil, false, &period)
+	return
+}
+
+// GetCurrentVotingPeriod - Returns the current voting period.
+func (tzkt *API) GetCurrentVotingPeriod(ctx context.Context) (period data.VotingPeriod, err error) {
+	err = tzkt.json(ctx, "/v1/voting/periods/current", nil, false, &period)
+	return
+}
+
+// GetVotingEpochs - Returns a list of voting epochs.
+func (tzkt *API) GetVotingEpochs(ctx context.Context, filters map[string]string) (epochs []data.VotingEpoch, err error) {
+	err = tzkt.json(ctx, "/v1/voting/epochs", filters, false, &epochs)
+	return
+}
+
+// GetVotingEpoch - Returns a voting epoch with the specified index.
+func (tzkt *API) GetVotingEpoch(ctx context.Context, index uint64) (epoch data.VotingEpoch, err error) {
+	err = tzkt.json(ctx, fmt.Sprintf("/v1/voting/epochs/%d", index), nil, false, &epoch)
+	return
+}
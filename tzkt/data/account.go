@@ -83,7 +83,15 @@ type Account struct {
 	Kind              string    `json:"kind"`
 	Tzips             []string  `json:"tzips"`
 	Alias             string    `json:"alias"`
+	PublicKey         string    `json:"publicKey,omitempty"`
+	Revealed          bool      `json:"revealed"`
+	Counter           int64     `json:"counter"`
 	Balance           int64     `json:"balance"`
+	StakedBalance     int64     `json:"stakedBalance"`
+	UnstakedBalance   int64     `json:"unstakedBalance"`
+	Delegate          *Address  `json:"delegate,omitempty"`
+	DelegationLevel   int64     `json:"delegationLevel,omitempty"`
+	DelegationTime    time.Time `json:"delegationTime,omitempty"`
 	Creator           Address   `json:"creator"`
 	NumContracts      int64     `json:"numContracts"`
 	NumDelegations    int64     `json:"numDelegations"`
@@ -98,3 +106,11 @@ type Account struct {
 	TypeHash          int64     `json:"typeHash"`
 	CodeHash          int64     `json:"codeHash"`
 }
+
+// HistoricalBalance -
+type HistoricalBalance struct {
+	Level     uint64    `json:"level"`
+	Timestamp time.Time `json:"timestamp"`
+	Balance   int64     `json:"balance"`
+	Quote     *Quote    `json:"quote,omitempty"`
+}
@@ -1,6 +1,10 @@
 package data
 
-import "time"
+import (
+	"time"
+
+	stdJSON "encoding/json"
+)
 
 // ContractJSONSchema -
 type ContractJSONSchema struct {
@@ -44,3 +48,23 @@ type Contract struct {
 	TypeHash            int       `json:"typeHash"`
 	CodeHash            int       `json:"codeHash"`
 }
+
+// Entrypoint -
+type Entrypoint struct {
+	Name                string             `json:"name"`
+	JSONParameters      stdJSON.RawMessage `json:"jsonParameters,omitempty"`
+	MichelineParameters stdJSON.RawMessage `json:"michelineParameters,omitempty"`
+	MichelsonParameters string             `json:"michelsonParameters,omitempty"`
+	Unused              bool               `json:"unused"`
+}
+
+// ContractView -
+type ContractView struct {
+	Name                   string             `json:"name"`
+	JSONParameterType      stdJSON.RawMessage `json:"jsonParameterType,omitempty"`
+	JSONReturnType         stdJSON.RawMessage `json:"jsonReturnType,omitempty"`
+	MichelineParameterType stdJSON.RawMessage `json:"michelineParameterType,omitempty"`
+	MichelineReturnType    stdJSON.RawMessage `json:"michelineReturnType,omitempty"`
+	MichelsonParameterType string             `json:"michelsonParameterType,omitempty"`
+	MichelsonReturnType    string             `json:"michelsonReturnType,omitempty"`
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"time"
+
+	stdJSON "encoding/json"
+
+	"github.com/dipdup-io/go-lib/tools/ast"
+	"github.com/pkg/errors"
+)
+
+// ErrEventTypeIsMissing - event doesn't contain `type` or `rawPayload` fields required for decoding
+var ErrEventTypeIsMissing = errors.New("event type or raw payload is missing")
+
+// ContractEvent - event emitted by `EMIT` instruction
+type ContractEvent struct {
+	ID            uint64             `json:"id"`
+	Level         uint64             `json:"level"`
+	Timestamp     time.Time          `json:"timestamp"`
+	Contract      Address            `json:"contract"`
+	CodeHash      int64              `json:"codeHash"`
+	Tag           string             `json:"tag"`
+	Payload       stdJSON.RawMessage `json:"payload,omitempty"`
+	TransactionID uint64             `json:"transactionId"`
+	Type          stdJSON.RawMessage `json:"type,omitempty"`
+	RawPayload    stdJSON.RawMessage `json:"rawPayload,omitempty"`
+}
+
+// Decode - settles Micheline payload of the event with the event type declared in the contract.
+// The event has to contain `type` and `rawPayload` fields.
+func (event ContractEvent) Decode() (*ast.TypedAst, error) {
+	if len(event.Type) == 0 || len(event.RawPayload) == 0 {
+		return nil, ErrEventTypeIsMissing
+	}
+
+	tree, err := ast.NewTypedAstFromBytes(event.Type)
+	if err != nil {
+		return nil, errors.Wrapf(err, "event type: %s %s", event.Contract.Address, event.Tag)
+	}
+	if err := tree.SettleFromBytes(event.RawPayload); err != nil {
+		return nil, errors.Wrapf(err, "event payload: %s %s", event.Contract.Address, event.Tag)
+	}
+	return tree, nil
+}
@@ -141,3 +141,49 @@ type Statistics struct {
 	TotalRollupBonds  uint64    `json:"totalRollupBonds"`
 	Quote             *Quote    `json:"quote,omitempty"`
 }
+
+// HistoricalQuote -
+type HistoricalQuote struct {
+	Level     uint64    `json:"level"`
+	Timestamp time.Time `json:"timestamp"`
+	Quote
+}
+
+// Constant - global constant registered by `register_global_constant` operation
+type Constant struct {
+	Address       string          `json:"address"`
+	Value         json.RawMessage `json:"value"`
+	Size          uint64          `json:"size"`
+	Refs          uint64          `json:"refs"`
+	Creator       *Address        `json:"creator,omitempty"`
+	CreationLevel uint64          `json:"creationLevel"`
+	CreationTime  time.Time       `json:"creationTime"`
+	Extras        json.RawMessage `json:"extras,omitempty"`
+}
+
+// SoftwareVersion - baker software identified by short commit hash
+type SoftwareVersion struct {
+	ShortHash   string          `json:"shortHash"`
+	FirstLevel  uint64          `json:"firstLevel"`
+	FirstTime   time.Time       `json:"firstTime"`
+	LastLevel   uint64          `json:"lastLevel"`
+	LastTime    time.Time       `json:"lastTime"`
+	BlocksCount uint64          `json:"blocksCount"`
+	Extras      json.RawMessage `json:"extras,omitempty"`
+}
+
+// Domain - Tezos domain
+type Domain struct {
+	ID         uint64            `json:"id"`
+	Level      uint64            `json:"level"`
+	Name       string            `json:"name"`
+	Owner      *Address          `json:"owner,omitempty"`
+	Address    *Address          `json:"address,omitempty"`
+	Reverse    bool              `json:"reverse"`
+	Expiration time.Time         `json:"expiration"`
+	Data       map[string]string `json:"data,omitempty"`
+	FirstLevel uint64            `json:"firstLevel"`
+	FirstTime  time.Time         `json:"firstTime"`
+	LastLevel  uint64            `json:"lastLevel"`
+	LastTime   time.Time         `json:"lastTime"`
+}
@@ -27,6 +27,11 @@ type Operation struct {
 	Hash       string      `json:"hash"`
 	Type       string      `json:"type"`
 	Block      string      `json:"block"`
+	Timestamp  time.Time   `json:"timestamp"`
+	Status     string      `json:"status,omitempty"`
+	Sender     *Address    `json:"sender,omitempty"`
+	Target     *Address    `json:"target,omitempty"`
+	Amount     *int64      `json:"amount,omitempty"`
 	Delegate   *Address    `json:"delegate,omitempty"`
 	GasUsed    *uint64     `json:"gasUsed,omitempty"`
 	BakerFee   *int64      `json:"bakerFee,omitempty"`
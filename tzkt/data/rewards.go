@@ -0,0 +1,109 @@
+package data
+
+// BakerRewards - baker's rewards for a cycle. Losses are negative.
+type BakerRewards struct {
+	Cycle                    uint64 `json:"cycle"`
+	OwnDelegatedBalance      int64  `json:"ownDelegatedBalance"`
+	ExternalDelegatedBalance int64  `json:"externalDelegatedBalance"`
+	DelegatorsCount          uint64 `json:"delegatorsCount"`
+	OwnStakedBalance         int64  `json:"ownStakedBalance"`
+	ExternalStakedBalance    int64  `json:"externalStakedBalance"`
+	StakersCount             uint64 `json:"stakersCount"`
+	BakingPower              int64  `json:"bakingPower"`
+	TotalBakingPower         int64  `json:"totalBakingPower"`
+
+	ExpectedBlocks       float64 `json:"expectedBlocks"`
+	ExpectedAttestations float64 `json:"expectedAttestations"`
+	FutureBlocks         uint64  `json:"futureBlocks"`
+	FutureBlockRewards   int64   `json:"futureBlockRewards"`
+	Blocks               uint64  `json:"blocks"`
+	MissedBlocks         uint64  `json:"missedBlocks"`
+	MissedBlockRewards   int64   `json:"missedBlockRewards"`
+	BlockFees            int64   `json:"blockFees"`
+	MissedBlockFees      int64   `json:"missedBlockFees"`
+
+	BlockRewardsDelegated    int64 `json:"blockRewardsDelegated"`
+	BlockRewardsStakedOwn    int64 `json:"blockRewardsStakedOwn"`
+	BlockRewardsStakedEdge   int64 `json:"blockRewardsStakedEdge"`
+	BlockRewardsStakedShared int64 `json:"blockRewardsStakedShared"`
+
+	FutureAttestations       uint64 `json:"futureAttestations"`
+	FutureAttestationRewards int64  `json:"futureAttestationRewards"`
+	Attestations             uint64 `json:"attestations"`
+	MissedAttestations       uint64 `json:"missedAttestations"`
+	MissedAttestationRewards int64  `json:"missedAttestationRewards"`
+
+	AttestationRewardsDelegated    int64 `json:"attestationRewardsDelegated"`
+	AttestationRewardsStakedOwn    int64 `json:"attestationRewardsStakedOwn"`
+	AttestationRewardsStakedEdge   int64 `json:"attestationRewardsStakedEdge"`
+	AttestationRewardsStakedShared int64 `json:"attestationRewardsStakedShared"`
+
+	DalAttestationRewardsDelegated    int64 `json:"dalAttestationRewardsDelegated"`
+	DalAttestationRewardsStakedOwn    int64 `json:"dalAttestationRewardsStakedOwn"`
+	DalAttestationRewardsStakedEdge   int64 `json:"dalAttestationRewardsStakedEdge"`
+	DalAttestationRewardsStakedShared int64 `json:"dalAttestationRewardsStakedShared"`
+
+	DoubleBakingRewards              int64 `json:"doubleBakingRewards"`
+	DoubleBakingLostStaked           int64 `json:"doubleBakingLostStaked"`
+	DoubleBakingLostUnstaked         int64 `json:"doubleBakingLostUnstaked"`
+	DoubleBakingLostExternalStaked   int64 `json:"doubleBakingLostExternalStaked"`
+	DoubleBakingLostExternalUnstaked int64 `json:"doubleBakingLostExternalUnstaked"`
+
+	DoubleConsensusRewards              int64 `json:"doubleConsensusRewards"`
+	DoubleConsensusLostStaked           int64 `json:"doubleConsensusLostStaked"`
+	DoubleConsensusLostUnstaked         int64 `json:"doubleConsensusLostUnstaked"`
+	DoubleConsensusLostExternalStaked   int64 `json:"doubleConsensusLostExternalStaked"`
+	DoubleConsensusLostExternalUnstaked int64 `json:"doubleConsensusLostExternalUnstaked"`
+
+	VdfRevelationRewardsDelegated      int64 `json:"vdfRevelationRewardsDelegated"`
+	VdfRevelationRewardsStakedOwn      int64 `json:"vdfRevelationRewardsStakedOwn"`
+	VdfRevelationRewardsStakedEdge     int64 `json:"vdfRevelationRewardsStakedEdge"`
+	VdfRevelationRewardsStakedShared   int64 `json:"vdfRevelationRewardsStakedShared"`
+	NonceRevelationRewardsDelegated    int64 `json:"nonceRevelationRewardsDelegated"`
+	NonceRevelationRewardsStakedOwn    int64 `json:"nonceRevelationRewardsStakedOwn"`
+	NonceRevelationRewardsStakedEdge   int64 `json:"nonceRevelationRewardsStakedEdge"`
+	NonceRevelationRewardsStakedShared int64 `json:"nonceRevelationRewardsStakedShared"`
+	NonceRevelationLosses              int64 `json:"nonceRevelationLosses"`
+
+	Quote *Quote `json:"quote,omitempty"`
+}
+
+// DelegatorRewards - delegator's share of baker's rewards for a cycle
+type DelegatorRewards struct {
+	Cycle                 uint64   `json:"cycle"`
+	DelegatedBalance      int64    `json:"delegatedBalance"`
+	StakedBalance         int64    `json:"stakedBalance"`
+	Baker                 *Address `json:"baker,omitempty"`
+	BakerDelegatedBalance int64    `json:"bakerDelegatedBalance"`
+	BakerStakedBalance    int64    `json:"bakerStakedBalance"`
+	BakingPower           int64    `json:"bakingPower"`
+	TotalBakingPower      int64    `json:"totalBakingPower"`
+
+	ExpectedBlocks       float64 `json:"expectedBlocks"`
+	ExpectedAttestations float64 `json:"expectedAttestations"`
+	FutureBlocks         uint64  `json:"futureBlocks"`
+	FutureBlockRewards   int64   `json:"futureBlockRewards"`
+	Blocks               uint64  `json:"blocks"`
+	MissedBlocks         uint64  `json:"missedBlocks"`
+	MissedBlockRewards   int64   `json:"missedBlockRewards"`
+	BlockFees            int64   `json:"blockFees"`
+	MissedBlockFees      int64   `json:"missedBlockFees"`
+
+	BlockRewardsDelegated    int64 `json:"blockRewardsDelegated"`
+	BlockRewardsStakedOwn    int64 `json:"blockRewardsStakedOwn"`
+	BlockRewardsStakedEdge   int64 `json:"blockRewardsStakedEdge"`
+	BlockRewardsStakedShared int64 `json:"blockRewardsStakedShared"`
+
+	FutureAttestations       uint64 `json:"futureAttestations"`
+	FutureAttestationRewards int64  `json:"futureAttestationRewards"`
+	Attestations             uint64 `json:"attestations"`
+	MissedAttestations       uint64 `json:"missedAttestations"`
+	MissedAttestationRewards int64  `json:"missedAttestationRewards"`
+
+	AttestationRewardsDelegated    int64 `json:"attestationRewardsDelegated"`
+	AttestationRewardsStakedOwn    int64 `json:"attestationRewardsStakedOwn"`
+	AttestationRewardsStakedEdge   int64 `json:"attestationRewardsStakedEdge"`
+	AttestationRewardsStakedShared int64 `json:"attestationRewardsStakedShared"`
+
+	Quote *Quote `json:"quote,omitempty"`
+}
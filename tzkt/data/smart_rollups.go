@@ -38,3 +38,29 @@ type SmartRollup struct {
 	LastActivity                uint64    `json:"lastActivity"`
 	LastActivityTime            time.Time `json:"lastActivityTime"`
 }
+
+// SmartRollupCommitment -
+type SmartRollupCommitment struct {
+	ID            uint64                      `json:"id"`
+	Rollup        *Address                    `json:"rollup,omitempty"`
+	Initiator     *Address                    `json:"initiator,omitempty"`
+	InboxLevel    uint64                      `json:"inboxLevel"`
+	State         string                      `json:"state"`
+	Hash          string                      `json:"hash"`
+	Ticks         uint64                      `json:"ticks"`
+	FirstLevel    uint64                      `json:"firstLevel"`
+	FirstTime     time.Time                   `json:"firstTime"`
+	LastLevel     uint64                      `json:"lastLevel"`
+	LastTime      time.Time                   `json:"lastTime"`
+	Stakers       uint64                      `json:"stakers"`
+	ActiveStakers uint64                      `json:"activeStakers"`
+	Successors    uint64                      `json:"successors"`
+	Status        string                      `json:"status"`
+	Predecessor   *SmartRollupCommitmentShort `json:"predecessor,omitempty"`
+}
+
+// SmartRollupCommitmentShort -
+type SmartRollupCommitmentShort struct {
+	ID   uint64 `json:"id"`
+	Hash string `json:"hash"`
+}
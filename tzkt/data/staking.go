@@ -0,0 +1,33 @@
+package data
+
+import "time"
+
+// staking update types
+const (
+	StakingUpdateStake         = "stake"
+	StakingUpdateUnstake       = "unstake"
+	StakingUpdateRestake       = "restake"
+	StakingUpdateFinalize      = "finalize"
+	StakingUpdateSlashStaked   = "slash_staked"
+	StakingUpdateSlashUnstaked = "slash_unstaked"
+)
+
+// StakingUpdate - change of staked or unstaked balance of the staker
+type StakingUpdate struct {
+	ID                  uint64    `json:"id"`
+	Level               uint64    `json:"level"`
+	Timestamp           time.Time `json:"timestamp"`
+	Cycle               uint64    `json:"cycle"`
+	Baker               *Address  `json:"baker,omitempty"`
+	Staker              *Address  `json:"staker,omitempty"`
+	Type                string    `json:"type"`
+	Amount              int64     `json:"amount"`
+	Pseudotokens        string    `json:"pseudotokens,omitempty"`
+	RoundingError       int64     `json:"roundingError,omitempty"`
+	AutostakingOpID     *uint64   `json:"autostakingOpId,omitempty"`
+	StakingOpID         *uint64   `json:"stakingOpId,omitempty"`
+	DelegationOpID      *uint64   `json:"delegationOpId,omitempty"`
+	DoubleBakingOpID    *uint64   `json:"doubleBakingOpId,omitempty"`
+	DoubleConsensusOpID *uint64   `json:"doubleConsensusOpId,omitempty"`
+	Quote               *Quote    `json:"quote,omitempty"`
+}
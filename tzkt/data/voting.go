@@ -0,0 +1,58 @@
+package data
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// VotingPeriod -
+type VotingPeriod struct {
+	Index            uint64    `json:"index"`
+	Epoch            uint64    `json:"epoch"`
+	FirstLevel       uint64    `json:"firstLevel"`
+	StartTime        time.Time `json:"startTime"`
+	LastLevel        uint64    `json:"lastLevel"`
+	EndTime          time.Time `json:"endTime"`
+	Kind             string    `json:"kind"`
+	Status           string    `json:"status"`
+	Dictator         string    `json:"dictator,omitempty"`
+	TotalBakers      uint64    `json:"totalBakers,omitempty"`
+	TotalVotingPower uint64    `json:"totalVotingPower,omitempty"`
+	UpvotesQuorum    float64   `json:"upvotesQuorum,omitempty"`
+	ProposalsCount   uint64    `json:"proposalsCount,omitempty"`
+	TopUpvotes       uint64    `json:"topUpvotes,omitempty"`
+	TopVotingPower   uint64    `json:"topVotingPower,omitempty"`
+	BallotsQuorum    float64   `json:"ballotsQuorum,omitempty"`
+	Supermajority    float64   `json:"supermajority,omitempty"`
+	YayBallots       uint64    `json:"yayBallots,omitempty"`
+	YayVotingPower   uint64    `json:"yayVotingPower,omitempty"`
+	NayBallots       uint64    `json:"nayBallots,omitempty"`
+	NayVotingPower   uint64    `json:"nayVotingPower,omitempty"`
+	PassBallots      uint64    `json:"passBallots,omitempty"`
+	PassVotingPower  uint64    `json:"passVotingPower,omitempty"`
+}
+
+// VotingEpoch -
+type VotingEpoch struct {
+	Index      uint64           `json:"index"`
+	FirstLevel uint64           `json:"firstLevel"`
+	StartTime  time.Time        `json:"startTime"`
+	LastLevel  uint64           `json:"lastLevel"`
+	EndTime    time.Time        `json:"endTime"`
+	Status     string           `json:"status"`
+	Periods    []VotingPeriod   `json:"periods"`
+	Proposals  []VotingProposal `json:"proposals"`
+}
+
+// VotingProposal -
+type VotingProposal struct {
+	Hash        string          `json:"hash"`
+	Initiator   *Address        `json:"initiator,omitempty"`
+	FirstPeriod uint64          `json:"firstPeriod"`
+	LastPeriod  uint64          `json:"lastPeriod"`
+	Epoch       uint64          `json:"epoch"`
+	Upvotes     uint64          `json:"upvotes"`
+	VotingPower uint64          `json:"votingPower"`
+	Status      string          `json:"status"`
+	Extras      json.RawMessage `json:"extras,omitempty"`
+}
@@ -10,6 +10,7 @@ const (
 	MethodTokenTransfers = "SubscribeToTokenTransfers"
 	MethodTokenBalances  = "SubscribeToTokenBalances"
 	MethodCycles         = "SubscribeToCycles"
+	MethodEvents         = "SubscribeToEvents"
 )
 
 // Channels
@@ -22,6 +23,7 @@ const (
 	ChannelTransfers     = "transfers"
 	ChannelCycles        = "cycles"
 	ChannelTokenBalances = "token_balances"
+	ChannelEvents        = "events"
 )
 
 // Big map tags
@@ -171,6 +171,23 @@ func (tzkt *TzKT) SubscribeToCycles(delayBlocks uint64) error {
 	return tzkt.subscribe(MethodCycles, args)
 }
 
+// SubscribeToEvents - subscribe to events channel. Sends contract events emitted by `EMIT` instruction.
+// Filters by `contract`, `codeHash` of contracts and event `tag` is applicable.
+// Payload can be decoded with `api.DecodeContractEvent`.
+func (tzkt *TzKT) SubscribeToEvents(contract string, codeHash *int64, tag string) error {
+	args := make(map[string]interface{})
+	if contract != "" {
+		args["contract"] = contract
+	}
+	if codeHash != nil {
+		args["codeHash"] = *codeHash
+	}
+	if tag != "" {
+		args["tag"] = tag
+	}
+	return tzkt.subscribe(MethodEvents, args)
+}
+
 func (tzkt *TzKT) subscribe(channel string, args ...interface{}) error {
 	tzkt.invokationID += 1
 	msg := signalr.NewInvocation(fmt.Sprintf("%d", tzkt.invokationID), channel, args...)
@@ -278,6 +295,10 @@ func parseData(channel string, data []byte) (any, error) {
 		var cycle tzktData.Cycle
 		err := json.Unmarshal(data, &cycle)
 		return cycle, err
+	case ChannelEvents:
+		var events []tzktData.ContractEvent
+		err := json.Unmarshal(data, &events)
+		return events, err
 	default:
 		return nil, errors.Errorf("unknown channel: %s", channel)
 	}